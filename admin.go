@@ -0,0 +1,387 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.fork.vn/config"
+	"go.fork.vn/di"
+)
+
+const (
+	// defaultAdminHost là host mặc định của admin server, chỉ lắng nghe trên localhost
+	defaultAdminHost = "127.0.0.1"
+	// defaultAdminPort là port mặc định của admin server
+	defaultAdminPort = 9090
+)
+
+// AdminServiceProvider là service provider khởi chạy admin HTTP server.
+//
+// Admin server lắng nghe trên một port riêng (mặc định 127.0.0.1:9090, cấu hình
+// qua "app.admin.host" và "app.admin.port") và cung cấp các endpoints:
+//   - /healthz: Liveness của application
+//   - /readyz: Readiness của application
+//   - /info: Tên, version, environment, thời gian boot và manifests của modules
+//   - /providers: Service providers theo thứ tự boot và trạng thái
+//   - /config: Config hiện tại với các giá trị nhạy cảm đã được ẩn
+//
+// Provider implement ShutdownProvider để dừng server khi application shutdown.
+type AdminServiceProvider struct {
+	mu       sync.Mutex
+	server   *http.Server
+	listener net.Listener
+}
+
+// NewAdminServiceProvider tạo admin service provider mới.
+//
+// Trả về:
+//   - *AdminServiceProvider: Provider instance
+//
+// Ví dụ:
+//
+//	app.Register(core.NewAdminServiceProvider())
+func NewAdminServiceProvider() *AdminServiceProvider {
+	return &AdminServiceProvider{}
+}
+
+// Register đăng ký provider vào container với key "admin".
+//
+// Implement di.ServiceProvider interface method.
+//
+// Tham số:
+//   - app: di.Application - Application instance
+func (p *AdminServiceProvider) Register(app di.Application) {
+	app.Instance("admin", p)
+}
+
+// Boot khởi chạy admin server.
+//
+// Implement di.ServiceProvider interface method.
+//
+// Panic nếu server không thể lắng nghe trên địa chỉ đã cấu hình.
+//
+// Tham số:
+//   - app: di.Application - Application instance
+func (p *AdminServiceProvider) Boot(app di.Application) {
+	if err := p.BootContext(context.Background(), app); err != nil {
+		panic(err)
+	}
+}
+
+// BootContext khởi chạy admin server.
+//
+// Implement ContextBooter interface method.
+//
+// Tham số:
+//   - ctx: context.Context - Context boot
+//   - app: di.Application - Application instance, phải implement core.Application
+//
+// Trả về:
+//   - error: Lỗi nếu app không phải core.Application hoặc không thể lắng nghe
+func (p *AdminServiceProvider) BootContext(ctx context.Context, app di.Application) error {
+	coreApp, ok := app.(Application)
+	if !ok {
+		return fmt.Errorf("admin server requires core.Application, got %T", app)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.server != nil {
+		return nil
+	}
+
+	addr := adminAddr(coreApp)
+	var lc net.ListenConfig
+	listener, err := lc.Listen(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("admin server failed to listen on %s: %w", addr, err)
+	}
+
+	p.listener = listener
+	p.server = &http.Server{
+		Handler:           NewAdminHandler(coreApp),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func(server *http.Server, listener net.Listener) {
+		_ = server.Serve(listener)
+	}(p.server, listener)
+
+	return nil
+}
+
+// Shutdown dừng admin server.
+//
+// Implement ShutdownProvider interface method.
+//
+// Tham số:
+//   - ctx: context.Context - Context giới hạn thời gian chờ các requests đang xử lý
+//   - app: di.Application - Application instance
+//
+// Trả về:
+//   - error: Lỗi nếu server không dừng kịp trước khi context hết hạn
+func (p *AdminServiceProvider) Shutdown(ctx context.Context, app di.Application) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.server == nil {
+		return nil
+	}
+
+	err := p.server.Shutdown(ctx)
+	p.server = nil
+	p.listener = nil
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// Addr trả về địa chỉ admin server đang lắng nghe.
+//
+// Trả về:
+//   - string: Địa chỉ dạng "host:port", rỗng nếu server chưa chạy
+func (p *AdminServiceProvider) Addr() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.listener == nil {
+		return ""
+	}
+	return p.listener.Addr().String()
+}
+
+// Requires trả về danh sách services mà provider phụ thuộc.
+//
+// Implement di.ServiceProvider interface method.
+//
+// Config được đọc nếu có nhưng không bắt buộc.
+//
+// Trả về:
+//   - []string: Danh sách rỗng
+func (p *AdminServiceProvider) Requires() []string {
+	return []string{}
+}
+
+// Providers trả về danh sách services mà provider cung cấp.
+//
+// Implement di.ServiceProvider interface method.
+//
+// Trả về:
+//   - []string: []string{"admin"}
+func (p *AdminServiceProvider) Providers() []string {
+	return []string{"admin"}
+}
+
+// adminAddr đọc địa chỉ admin server từ config.
+//
+// Tham số:
+//   - app: Application - Application instance
+//
+// Trả về:
+//   - string: Địa chỉ dạng "host:port"
+func adminAddr(app Application) string {
+	host, port := defaultAdminHost, defaultAdminPort
+	if cfg, ok := appConfig(app); ok {
+		if value, ok := cfg.GetString("app.admin.host"); ok && value != "" {
+			host = value
+		}
+		if value, ok := cfg.GetInt("app.admin.port"); ok && value >= 0 {
+			port = value
+		}
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// appConfig lấy config manager của application mà không panic khi chưa đăng ký.
+//
+// Tham số:
+//   - app: Application - Application instance
+//
+// Trả về:
+//   - config.Manager: Config manager
+//   - bool: true nếu config manager đã được đăng ký
+func appConfig(app Application) (config.Manager, bool) {
+	instance, err := app.Make("config")
+	if err != nil {
+		return nil, false
+	}
+	cfg, ok := instance.(config.Manager)
+	return cfg, ok
+}
+
+// AppInfo là thông tin tổng quan của application trả về từ endpoint /info.
+type AppInfo struct {
+	// Name là tên application từ config "app.name"
+	Name string `json:"name"`
+	// Version là version application từ config "app.version"
+	Version string `json:"version"`
+	// Environment là môi trường chạy từ config "app.environment"
+	Environment string `json:"environment"`
+	// State là trạng thái lifecycle hiện tại
+	State string `json:"state"`
+	// BootedAt là thời điểm bắt đầu boot, zero nếu chưa boot
+	BootedAt time.Time `json:"booted_at"`
+	// BootDuration là tổng thời gian boot providers
+	BootDuration time.Duration `json:"boot_duration"`
+	// CoreVersion là version của go.fork.vn/core
+	CoreVersion string `json:"core_version"`
+	// Modules là manifests của các modules đã load
+	Modules []ModuleManifest `json:"modules"`
+}
+
+// ProviderInfo là thông tin một provider trả về từ endpoint /providers.
+type ProviderInfo struct {
+	// Name là tên (type) của provider
+	Name string `json:"name"`
+	// Level là dependency level, -1 nếu không boot theo dependency
+	Level int `json:"level"`
+	// DependsOn là danh sách providers mà provider phụ thuộc
+	DependsOn []string `json:"depends_on,omitempty"`
+	// State là "booted" nếu provider đã boot thành công, ngược lại "pending"
+	State string `json:"state"`
+	// BootDuration là thời gian boot provider
+	BootDuration time.Duration `json:"boot_duration"`
+}
+
+// NewAdminHandler tạo http.Handler cho các admin endpoints của application.
+//
+// Handler có thể được mount vào server có sẵn thay vì dùng AdminServiceProvider.
+//
+// Tham số:
+//   - app: Application - Application cần introspect
+//
+// Trả về:
+//   - http.Handler: Handler phục vụ /healthz, /readyz, /info, /providers và /config;
+//     /metrics phục vụ metrics của application nếu Metrics() implement http.Handler
+//     (ví dụ PrometheusMetrics), ngược lại trả về 404
+//
+// Ví dụ:
+//
+//	mux.Handle("/admin/", http.StripPrefix("/admin", core.NewAdminHandler(app)))
+func NewAdminHandler(app Application) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		report := app.Health(r.Context())
+		writeAdminJSON(w, healthStatusCode(report.Live), report)
+	})
+
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		report := app.Health(r.Context())
+		writeAdminJSON(w, healthStatusCode(report.Ready), report)
+	})
+
+	mux.HandleFunc("/info", func(w http.ResponseWriter, r *http.Request) {
+		writeAdminJSON(w, http.StatusOK, appInfo(app))
+	})
+
+	mux.HandleFunc("/providers", func(w http.ResponseWriter, r *http.Request) {
+		writeAdminJSON(w, http.StatusOK, providerInfos(app.BootReport()))
+	})
+
+	mux.HandleFunc("/config", func(w http.ResponseWriter, r *http.Request) {
+		settings := map[string]interface{}{}
+		if cfg, ok := appConfig(app); ok {
+			settings = redactSettings(cfg.AllSettings())
+		}
+		writeAdminJSON(w, http.StatusOK, settings)
+	})
+
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		handler, ok := app.Metrics().(http.Handler)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+
+	return mux
+}
+
+// appInfo thu thập thông tin tổng quan của application.
+//
+// Tham số:
+//   - app: Application - Application instance
+//
+// Trả về:
+//   - AppInfo: Thông tin application
+func appInfo(app Application) AppInfo {
+	report := app.BootReport()
+	info := AppInfo{
+		State:        app.State().String(),
+		BootedAt:     report.StartedAt,
+		BootDuration: report.BootDuration,
+		CoreVersion:  Version,
+		Modules:      app.Modules(),
+	}
+
+	if cfg, ok := appConfig(app); ok {
+		info.Name, _ = cfg.GetString("app.name")
+		info.Version, _ = cfg.GetString("app.version")
+		info.Environment, _ = cfg.GetString("app.environment")
+	}
+	return info
+}
+
+// providerInfos chuyển boot report thành danh sách ProviderInfo theo thứ tự boot.
+//
+// Tham số:
+//   - report: *BootReport - Boot report của application
+//
+// Trả về:
+//   - []ProviderInfo: Thông tin từng provider
+func providerInfos(report *BootReport) []ProviderInfo {
+	infos := make([]ProviderInfo, 0, len(report.Providers))
+	for _, timing := range report.Providers {
+		state := "pending"
+		if timing.Booted {
+			state = "booted"
+		}
+		infos = append(infos, ProviderInfo{
+			Name:         timing.Name,
+			Level:        timing.Level,
+			DependsOn:    timing.DependsOn,
+			State:        state,
+			BootDuration: timing.BootDuration,
+		})
+	}
+	return infos
+}
+
+// healthStatusCode trả về HTTP status code tương ứng với kết quả health check.
+//
+// Tham số:
+//   - healthy: bool - Kết quả check
+//
+// Trả về:
+//   - int: 200 nếu healthy, 503 nếu không
+func healthStatusCode(healthy bool) int {
+	if healthy {
+		return http.StatusOK
+	}
+	return http.StatusServiceUnavailable
+}
+
+// writeAdminJSON ghi response JSON.
+//
+// Tham số:
+//   - w: http.ResponseWriter - Response writer
+//   - status: int - HTTP status code
+//   - body: interface{} - Giá trị được encode thành JSON
+func writeAdminJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(body)
+}
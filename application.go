@@ -1,8 +1,12 @@
 package core
 
 import (
+	"context"
 	"fmt"
 	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.fork.vn/config"
 	"go.fork.vn/di"
@@ -67,6 +71,326 @@ type Application interface {
 	// Trả về:
 	//   - di.ModuleLoaderContract: Module loader instance
 	ModuleLoader() ModuleLoaderContract
+
+	// BootReport trả về report timing của quá trình register và boot providers.
+	//
+	// Report bao gồm thời gian register/boot, allocations của từng provider và
+	// critical path qua dependency graph. Khi config "app.debug" được bật, report
+	// cũng được log dưới dạng bảng qua Log() sau khi boot.
+	//
+	// Trả về:
+	//   - *BootReport: Snapshot của report tại thời điểm gọi
+	//
+	// Ví dụ:
+	//   - report := app.BootReport()
+	//   - fmt.Println(report.Table())
+	BootReport() *BootReport
+
+	// State trả về trạng thái lifecycle hiện tại của application.
+	//
+	// Trả về:
+	//   - State: StateCreated, StateBooting, StateBooted, StateFailed, StateShuttingDown hoặc StateShutdown
+	State() State
+
+	// Health chạy health checks của các providers và trả về report tổng hợp.
+	//
+	// Providers implement HealthChecker cung cấp liveness và readiness checks,
+	// được chạy đồng thời với timeout riêng. Readiness luôn là false cho tới khi
+	// BootServiceProviders hoàn tất và trong lúc shutdown.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context giới hạn thời gian chạy checks
+	//
+	// Trả về:
+	//   - *HealthReport: Report tổng hợp với kết quả từng check
+	//
+	// Ví dụ:
+	//   - report := app.Health(ctx)
+	//   - if !report.Ready { ... }
+	Health(ctx context.Context) *HealthReport
+
+	// Shutdown shutdown tất cả service providers đã boot.
+	//
+	// Providers implement ShutdownProvider được gọi theo thứ tự ngược với thứ tự boot.
+	// Trong lúc shutdown, Health() báo application không ready.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context giới hạn thời gian shutdown
+	//
+	// Trả về:
+	//   - error: Lỗi gộp của các providers shutdown thất bại
+	Shutdown(ctx context.Context) error
+
+	// BootContext đăng ký và boot tất cả service providers với context.
+	//
+	// Giống Boot() nhưng dừng lại khi context bị hủy. Mỗi provider được boot
+	// với timeout đọc từ config "app.boot.timeout" (nếu có). Provider implement
+	// ContextBooter nhận context để tự hủy các thao tác chậm.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context điều khiển quá trình boot
+	//
+	// Trả về:
+	//   - error: BootTimeoutError nếu provider bị treo, ProviderBootError nếu boot thất bại
+	//
+	// Ví dụ:
+	//   - ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	//   - defer cancel()
+	//   - err := app.BootContext(ctx)
+	BootContext(ctx context.Context) error
+
+	// BootServiceProvidersContext boot tất cả service providers đã đăng ký với context.
+	//
+	// Giống BootServiceProviders() nhưng dừng lại khi context bị hủy và trả về
+	// lỗi chỉ rõ provider đang boot dở.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context điều khiển quá trình boot
+	//
+	// Trả về:
+	//   - error: BootTimeoutError nếu provider bị treo, ProviderBootError nếu boot thất bại
+	BootServiceProvidersContext(ctx context.Context) error
+
+	// MakeContext resolve dependency từ container với context.
+	//
+	// Trả về ngay khi context bị hủy, kể cả khi factory của binding đang bị treo.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context điều khiển việc resolve
+	//   - abstract: string - Abstract type name
+	//
+	// Trả về:
+	//   - interface{}: Resolved instance
+	//   - error: Lỗi nếu resolve thất bại hoặc context bị hủy
+	MakeContext(ctx context.Context, abstract string) (interface{}, error)
+
+	// CallContext gọi function với auto dependency injection và context.
+	//
+	// Trả về ngay khi context bị hủy, kể cả khi callback đang bị treo.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context điều khiển lời gọi
+	//   - callback: interface{} - Function để gọi
+	//   - additionalParams: ...interface{} - Additional parameters
+	//
+	// Trả về:
+	//   - []interface{}: Function return values
+	//   - error: Lỗi nếu call thất bại hoặc context bị hủy
+	CallContext(ctx context.Context, callback interface{}, additionalParams ...interface{}) ([]interface{}, error)
+
+	// CallSafe gọi function với auto dependency injection, recover panic thành error.
+	//
+	// Parameter không inject được trả về *InjectionError với vị trí và type của
+	// parameter; panic trong callback trả về *CallPanicError. Hint Named(index,
+	// abstract) trong params inject service theo tên thay vì theo type.
+	//
+	// Tham số:
+	//   - callback: interface{} - Function để gọi
+	//   - params: ...interface{} - Additional parameters và Inject hints
+	//
+	// Trả về:
+	//   - []interface{}: Function return values
+	//   - error: *InjectionError, *CallPanicError hoặc lỗi từ container
+	//
+	// Ví dụ:
+	//   - app.CallSafe(func(db *sql.DB) error { ... }, core.Named(0, "db.replica"))
+	CallSafe(callback interface{}, params ...interface{}) ([]interface{}, error)
+
+	// CallSafeContext gọi CallSafe với context, trả về ngay khi context bị hủy.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context điều khiển lời gọi
+	//   - callback: interface{} - Function để gọi
+	//   - params: ...interface{} - Additional parameters và Inject hints
+	//
+	// Trả về:
+	//   - []interface{}: Function return values
+	//   - error: Lỗi từ CallSafe hoặc ctx.Err() nếu context bị hủy
+	CallSafeContext(ctx context.Context, callback interface{}, params ...interface{}) ([]interface{}, error)
+
+	// ServiceProviders trả về danh sách service providers đã đăng ký.
+	//
+	// Trả về:
+	//   - []di.ServiceProvider: Bản sao danh sách providers theo thứ tự đăng ký
+	ServiceProviders() []di.ServiceProvider
+
+	// BootServicesContext chỉ boot các providers cung cấp services chỉ định và dependencies của chúng.
+	//
+	// Providers phải đã được đăng ký qua RegisterWithDependencies() hoặc
+	// RegisterServiceProviders(). Providers đã boot được bỏ qua, nên gọi
+	// BootServiceProviders() sau đó chỉ boot các providers còn lại.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context điều khiển quá trình boot
+	//   - services: ...string - Tên services cần sẵn sàng
+	//
+	// Trả về:
+	//   - error: Lỗi nếu service không có provider hoặc boot thất bại
+	//
+	// Ví dụ:
+	//   - err := app.BootServicesContext(ctx, "database", "cache")
+	BootServicesContext(ctx context.Context, services ...string) error
+
+	// Plan phân tích dependency graph của các providers đã đăng ký mà không gây side effects.
+	//
+	// Không provider nào được Register hay Boot. Plan chứa thứ tự boot dự kiến
+	// và tất cả vấn đề tìm được: missing services, circular dependencies,
+	// providers đăng ký trùng, services được nhiều providers cung cấp và
+	// services không được provider nào require.
+	//
+	// Trả về:
+	//   - *Plan: Thứ tự boot dự kiến và các vấn đề tìm được
+	//
+	// Ví dụ:
+	//   - plan := app.Plan()
+	//   - for _, problem := range plan.Warnings() { ... }
+	Plan() *Plan
+
+	// Validate kiểm tra dependency graph mà không đăng ký hay boot providers.
+	//
+	// Trả về:
+	//   - error: *ValidationError chứa tất cả vấn đề ở mức SeverityError, nil nếu hợp lệ
+	//
+	// Ví dụ:
+	//   - if err := app.Validate(); err != nil { log.Fatal(err) }
+	Validate() error
+
+	// LoadProviders đăng ký và boot providers vào application đã boot.
+	//
+	// Dependencies của providers mới được kiểm tra với các providers đã đăng ký;
+	// batch được sắp xếp theo dependency order và thêm vào thứ tự boot hiện tại
+	// để Shutdown() vẫn giải phóng providers theo đúng thứ tự.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context boot
+	//   - providers: ...di.ServiceProvider - Providers cần load
+	//
+	// Trả về:
+	//   - error: *MissingDependenciesError, lỗi circular dependency hoặc lỗi boot
+	LoadProviders(ctx context.Context, providers ...di.ServiceProvider) error
+
+	// UnloadProvider gỡ một provider đã đăng ký khỏi application.
+	//
+	// Provider chỉ được gỡ khi không provider còn lại nào require services của nó.
+	// Teardown qua ShutdownProvider được gọi nếu provider đã boot, sau đó bindings
+	// của provider bị gỡ khỏi container (Bound trả về false, Make trả về lỗi) và
+	// provider bị loại khỏi thứ tự boot.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context cho teardown của provider
+	//   - provider: di.ServiceProvider - Provider cần gỡ
+	//
+	// Trả về:
+	//   - error: *ProviderInUseError nếu còn providers phụ thuộc, hoặc lỗi teardown
+	UnloadProvider(ctx context.Context, provider di.ServiceProvider) error
+
+	// ReplaceProvider thay một provider đã đăng ký bằng provider mới mà không dừng application.
+	//
+	// Replacement được register và boot trên staging container trước; bindings
+	// của nó chỉ thay bindings của provider cũ khi boot thành công, sau đó
+	// provider cũ được teardown. Nếu boot thất bại, container không thay đổi.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context cho boot và teardown
+	//   - old: di.ServiceProvider - Provider đang được sử dụng
+	//   - replacement: di.ServiceProvider - Provider thay thế
+	//
+	// Trả về:
+	//   - error: Lỗi dependency, lỗi boot (đã rollback) hoặc lỗi teardown
+	ReplaceProvider(ctx context.Context, old, replacement di.ServiceProvider) error
+
+	// Swap thay binding của service bằng implementation khác, thường dùng trong tests.
+	//
+	// Các services có factory đã resolve service bị thay trước đó được liệt kê
+	// trong BindingSwap.Stale vì có thể vẫn giữ reference tới instance cũ.
+	//
+	// Tham số:
+	//   - abstract: string - Tên service cần thay
+	//   - impl: interface{} - Instance thay thế
+	//
+	// Trả về:
+	//   - *BindingSwap: Swap đã thực hiện, gọi Restore() để khôi phục binding gốc
+	//   - error: Lỗi nếu service chưa được bind hoặc không resolve được
+	//
+	// Ví dụ:
+	//   - swap, _ := app.Swap("mailer", &fakeMailer{})
+	//   - defer swap.Restore()
+	Swap(abstract string, impl interface{}) (*BindingSwap, error)
+
+	// Modules trả về manifest của các providers implement Module.
+	//
+	// Manifests được kiểm tra tương thích (tên duy nhất, version, MinCoreVersion
+	// và Requires) khi BootstrapApplication() và khi load modules.
+	//
+	// Trả về:
+	//   - []ModuleManifest: Manifests theo thứ tự đăng ký, Provider được điền tên provider
+	//
+	// Ví dụ:
+	//   - for _, m := range app.Modules() { fmt.Println(m.Name, m.Version) }
+	Modules() []ModuleManifest
+
+	// Metrics trả về metrics đã cấu hình qua SetMetrics().
+	//
+	// Trả về:
+	//   - Metrics: Metrics hiện tại, nil nếu chưa cấu hình
+	Metrics() Metrics
+
+	// SetMetrics cấu hình metrics cho lifecycle và container resolution.
+	//
+	// Sau khi cấu hình, application ghi nhận thời gian Register/Boot của từng
+	// provider, tổng thời gian boot, số lần và thời gian resolve qua Make/MustMake
+	// theo abstract, số lần resolve thất bại và trạng thái lifecycle. Nên gọi
+	// trước khi bootstrap để ghi nhận được quá trình boot.
+	//
+	// Tham số:
+	//   - metrics: Metrics - Metrics implementation, nil để tắt
+	//
+	// Ví dụ:
+	//   - metrics := core.NewPrometheusMetrics()
+	//   - app.SetMetrics(metrics)
+	//   - http.Handle("/metrics", metrics)
+	SetMetrics(metrics Metrics)
+
+	// Tracer trả về tracer đã cấu hình qua SetTracer().
+	//
+	// Trả về:
+	//   - Tracer: Tracer hiện tại, nil nếu chưa cấu hình
+	Tracer() Tracer
+
+	// SetTracer cấu hình tracing hook cho bootstrap và container resolution.
+	//
+	// Sau khi cấu hình, BootstrapApplication() tạo span "bootstrap" chứa các
+	// spans con cho việc nạp config, Register và Boot của từng provider; mỗi
+	// lần Make/MustMake tạo span "container.make". Nên gọi trước khi bootstrap.
+	//
+	// Tham số:
+	//   - tracer: Tracer - Tracer implementation, nil để tắt
+	//
+	// Ví dụ:
+	//   - tracer, _ := core.NewFileTracer("startup-trace.jsonl")
+	//   - defer tracer.Close()
+	//   - app.SetTracer(tracer)
+	SetTracer(tracer Tracer)
+
+	// EnableAudit bật audit mode cho container resolution.
+	//
+	// Khi bật, mỗi lần Make/MustMake/Call (kèm vị trí gọi) và mỗi binding qua
+	// Bind/Singleton/Instance/Alias được ghi nhận để AuditReport() liệt kê
+	// services được dùng nhiều nhất và bindings chưa từng được resolve. Audit mode
+	// cũng được bật qua config "app.audit.enabled" khi BootstrapApplication().
+	// Nên bật trước khi đăng ký providers để bindings của chúng được ghi nhận.
+	EnableAudit()
+
+	// AuditReport trả về thống kê resolution của container.
+	//
+	// Trả về:
+	//   - *AuditReport: Snapshot của report, nil nếu audit mode chưa bật
+	//
+	// Ví dụ:
+	//   - app.EnableAudit()
+	//   - // ... bootstrap và chạy application
+	//   - fmt.Print(app.AuditReport().Table())
+	AuditReport() *AuditReport
 }
 
 // application là concrete implementation của Application interface.
@@ -75,16 +399,43 @@ type Application interface {
 // và các extension methods từ Application interface.
 //
 // Fields:
-//   - container: DI container instance để quản lý dependencies
+//   - container: DI container instance để quản lý dependencies, hỗ trợ gỡ bindings khi unload provider
 //   - providers: Slice các registered service providers
+//   - sortedProviders: Providers đã sắp xếp theo dependency order
+//   - bootLevels: Providers nhóm theo dependency level, dùng cho parallel boot
+//   - bindings: Bindings mỗi provider tạo trong Register, theo provider key, để gỡ khi unload
+//   - providersMu: Bảo vệ providers, sortedProviders, bootLevels và bindings khi đọc đồng thời
+//   - modulesMu: Tuần tự hóa LoadProviders, UnloadProvider và ReplaceProvider
 //   - booted: Flag đánh dấu providers đã được booted
 //   - loader: Module loader instance
+//   - recorder: Ghi nhận timing register/boot cho BootReport()
+//   - state: Trạng thái lifecycle, đọc/ghi atomic để Health() an toàn khi gọi đồng thời
+//   - events: Lifecycle events chờ log manager sẵn sàng
+//   - metrics: Metrics ghi nhận lifecycle và resolution, nil nếu chưa cấu hình
+//   - tracer: Tracer tạo spans cho bootstrap và resolution, nil nếu chưa cấu hình
+//   - audit: Thống kê resolution khi audit mode được bật, nil nếu chưa bật
+//   - shutdownDone: Đóng khi Shutdown() đầu tiên kết thúc
+//   - shutdownErr: Kết quả của Shutdown() đầu tiên, đọc sau khi shutdownDone đóng
 type application struct {
-	container       di.Container
+	container       *bindingContainer
 	providers       []di.ServiceProvider
-	sortedProviders []di.ServiceProvider // Providers sorted by dependency order
+	sortedProviders []di.ServiceProvider   // Providers sorted by dependency order
+	bootLevels      [][]di.ServiceProvider // Providers grouped by dependency level
+	bindings        map[string][]*bindingDefinition
+	providersMu     sync.RWMutex
+	modulesMu       sync.Mutex
 	booted          bool
 	loader          ModuleLoaderContract
+	recorder        *bootRecorder
+	state           atomic.Int32
+	events          *lifecycleLog
+	metricsMu       sync.RWMutex
+	metrics         Metrics
+	tracerMu        sync.RWMutex
+	tracer          Tracer
+	audit           atomic.Pointer[auditLog]
+	shutdownDone    chan struct{}
+	shutdownErr     error
 }
 
 // New tạo một Application instance mới với config chỉ định.
@@ -95,6 +446,12 @@ type application struct {
 //   - Config được chỉ định
 //   - Module loader được configured
 //
+// Config được đọc từ file qua "file" hoặc "name"/"path"/"type". Thay vì
+// filesystem, config có thể được cung cấp inline:
+//   - "settings": map[string]interface{} - Config dạng nested maps
+//   - "data": []byte hoặc string cùng "type" ("yaml", "json", "toml") - Nội dung config
+//   - "fs": fs.FS (ví dụ embed.FS) cùng "file" - File config trong fs.FS
+//
 // Tham số:
 //   - config: map[string]interface{} - Cấu hình cho ứng dụng
 //
@@ -108,6 +465,10 @@ type application struct {
 //	    "path": "./configs",
 //	}
 //	app := app.New(config)
+//
+//	//go:embed configs/app.yaml
+//	var configFS embed.FS
+//	app := app.New(map[string]interface{}{"fs": configFS, "file": "configs/app.yaml"})
 
 func New(config map[string]interface{}) Application {
 	// Validate config
@@ -116,16 +477,22 @@ func New(config map[string]interface{}) Application {
 	}
 
 	// Tạo DI container
-	container := di.New()
+	container := newBindingContainer(di.New())
 
 	// Khởi tạo app instance
 	a := &application{
 		container:       container,
 		providers:       make([]di.ServiceProvider, 0),
 		sortedProviders: make([]di.ServiceProvider, 0),
+		bindings:        make(map[string][]*bindingDefinition),
 		booted:          false,
+		recorder:        newBootRecorder(),
+		events:          &lifecycleLog{},
+		shutdownDone:    make(chan struct{}),
 	}
 
+	container.onResolve = a.auditResolve
+
 	// Register app config
 	a.Instance("app.config", config)
 
@@ -155,7 +522,9 @@ func (a *application) Container() di.Container {
 // Panics:
 //   - Nếu config manager chưa được đăng ký hoặc không đúng type
 func (a *application) Config() config.Manager {
-	return a.container.MustMake("config").(config.Manager)
+	manager := a.container.MustMake("config").(config.Manager)
+	a.auditResolve("config")
+	return manager
 }
 
 // Log trả về log manager instance.
@@ -168,7 +537,9 @@ func (a *application) Config() config.Manager {
 // Panics:
 //   - Nếu log manager chưa được đăng ký hoặc không đúng type
 func (a *application) Log() log.Manager {
-	return a.container.MustMake("log").(log.Manager)
+	manager := a.container.MustMake("log").(log.Manager)
+	a.auditResolve("log")
+	return manager
 }
 
 // ModuleLoader trả về module loader instance.
@@ -188,8 +559,22 @@ func (a *application) ModuleLoader() ModuleLoaderContract {
 // Trả về:
 //   - error: Lỗi nếu có provider registration thất bại
 func (a *application) RegisterServiceProviders() error {
-	for _, provider := range a.providers {
-		provider.Register(a)
+	return a.registerServiceProviders(context.Background())
+}
+
+// registerServiceProviders đăng ký providers theo thứ tự đăng ký với context.
+//
+// Tham số:
+//   - ctx: context.Context - Context điều khiển việc đăng ký, chứa span cha của các spans register
+//
+// Trả về:
+//   - error: ctx.Err() nếu context bị hủy trước khi đăng ký hết providers
+func (a *application) registerServiceProviders(ctx context.Context) error {
+	for _, provider := range a.ServiceProviders() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		a.registerProvider(ctx, a, provider)
 	}
 	return nil
 }
@@ -199,41 +584,63 @@ func (a *application) RegisterServiceProviders() error {
 // Implement di.Application interface method. Phương thức này sắp xếp
 // các providers theo dependency requirements và đăng ký theo thứ tự đúng.
 //
-// Sử dụng topological sort để xác định thứ tự đăng ký dựa trên:
+// Sử dụng providerGraph (cùng dependency graph với Plan và LoadProviders) để
+// xác định thứ tự đăng ký dựa trên:
 //   - Requires() method của mỗi provider
 //   - Providers() method để biết provider nào cung cấp service nào
 //
+// Khi config "app.providers.strict" được bật, bindings của mỗi provider được
+// so sánh với Providers() sau khi Register: services khai báo nhưng không được
+// bind gây lỗi, services được bind nhưng không khai báo được log warning.
+//
 // Trả về:
-//   - error: Lỗi nếu có circular dependency hoặc missing dependency
+//   - error: Lỗi nếu có circular dependency hoặc missing dependency,
+//     *UnboundServicesError nếu strict mode phát hiện services không được bind
 func (a *application) RegisterWithDependencies() error {
-	// Xây dựng dependency graph
-	providerMap := make(map[string]di.ServiceProvider)
-	serviceToProvider := make(map[string]string)
-
-	// Bước 1: Map providers và services
-	for _, provider := range a.providers {
-		// Tạo unique key cho provider (sử dụng type name)
-		providerKey := getProviderKey(provider)
-		providerMap[providerKey] = provider
-
-		// Map services tới provider
-		for _, service := range provider.Providers() {
-			serviceToProvider[service] = providerKey
-		}
-	}
+	return a.registerWithDependencies(context.Background())
+}
 
-	// Bước 2: Topological sort
-	sortedProviders, err := a.topologicalSort(providerMap, serviceToProvider)
+// registerWithDependencies đăng ký providers theo thứ tự dependency với context.
+//
+// Tham số:
+//   - ctx: context.Context - Context điều khiển việc đăng ký, chứa span cha của các spans register
+//
+// Trả về:
+//   - error: Lỗi như RegisterWithDependencies(), hoặc ctx.Err() nếu context bị hủy
+func (a *application) registerWithDependencies(ctx context.Context) error {
+	// Bước 1: Xây dựng dependency graph và sắp xếp theo dependency level
+	providers := a.ServiceProviders()
+	levels, err := newProviderGraph(providers).sortedLevels()
 	if err != nil {
 		return err
 	}
 
-	// Bước 3: Lưu sorted providers để dùng cho boot
+	sortedProviders := make([]di.ServiceProvider, 0, len(providers))
+	for _, level := range levels {
+		sortedProviders = append(sortedProviders, level...)
+	}
+
+	// Bước 2: Lưu sorted providers và dependency levels để dùng cho boot
+	a.providersMu.Lock()
 	a.sortedProviders = sortedProviders
+	a.bootLevels = levels
+	a.providersMu.Unlock()
 
-	// Bước 4: Đăng ký theo thứ tự sorted
+	// Bước 3: Đăng ký theo thứ tự sorted, kiểm tra bindings ở strict mode
+	strict := a.strictProviders()
+	unbound := make([]UnboundService, 0)
 	for _, provider := range sortedProviders {
-		provider.Register(a)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if strict {
+			unbound = append(unbound, a.registerStrict(ctx, provider)...)
+			continue
+		}
+		a.registerProvider(ctx, a, provider)
+	}
+	if len(unbound) > 0 {
+		return &UnboundServicesError{Unbound: unbound}
 	}
 
 	return nil
@@ -246,24 +653,71 @@ func (a *application) RegisterWithDependencies() error {
 // Boot theo thứ tự dependency nếu đã có sortedProviders từ RegisterWithDependencies(),
 // nếu không thì boot theo thứ tự đăng ký thông thường.
 //
+// Khi config "app.boot.parallel" được bật và providers đã được đăng ký qua
+// RegisterWithDependencies(), các providers trong cùng dependency level được
+// boot đồng thời với tối đa "app.boot.workers" goroutines.
+//
 // Trả về:
 //   - error: Lỗi nếu có provider boot thất bại
 func (a *application) BootServiceProviders() error {
+	return a.BootServiceProvidersContext(context.Background())
+}
+
+// BootServiceProvidersContext boot tất cả service providers đã đăng ký với context.
+//
+// Implement Application interface method.
+//
+// Mỗi bước boot (bắt đầu, kết thúc kèm duration, providers bị bỏ qua, lỗi) được
+// log qua Log() dưới dạng lifecycle events; events phát sinh trước khi log
+// manager sẵn sàng được giữ lại và log khi provider cung cấp "log" đã boot.
+//
+// Tham số:
+//   - ctx: context.Context - Context điều khiển quá trình boot
+//
+// Trả về:
+//   - error: Lỗi nếu có provider boot thất bại, bị timeout hoặc context bị hủy
+func (a *application) BootServiceProvidersContext(ctx context.Context) error {
 	if a.booted {
 		return nil
 	}
 
 	// Sử dụng sorted providers nếu có, không thì dùng providers gốc
-	providersToBoot := a.providers
-	if len(a.sortedProviders) > 0 {
-		providersToBoot = a.sortedProviders
+	providersToBoot := a.bootOrder()
+	levels := a.providerLevels()
+
+	a.setState(StateBooting)
+	options := a.bootOptions()
+	parallel := options.parallel && len(levels) > 0
+	mode := "sequential"
+	if parallel {
+		mode = "parallel"
+	}
+	a.logEvent("info", "boot.start", "mode", mode, "providers", len(providersToBoot))
+	start := time.Now()
+
+	var err error
+	if parallel {
+		err = a.bootParallel(ctx, levels, options)
+	} else {
+		err = a.bootSequential(ctx, providersToBoot, options)
 	}
 
-	for _, provider := range providersToBoot {
-		provider.Boot(a)
+	elapsed := time.Since(start)
+	a.recorder.finishBoot(elapsed, parallel)
+	a.recordBootDuration(elapsed)
+	if err != nil {
+		a.logEvent("error", "boot.failed", "duration", elapsed, "error", err)
+		a.flushEvents(true)
+		a.logBootReport()
+		a.setState(StateFailed)
+		return err
 	}
+	a.logEvent("info", "boot.end", "duration", elapsed)
+	a.flushEvents(true)
+	a.logBootReport()
 
 	a.booted = true
+	a.setState(StateBooted)
 	return nil
 }
 
@@ -277,7 +731,57 @@ func (a *application) Register(provider di.ServiceProvider) {
 	if provider == nil {
 		panic("service provider cannot be nil")
 	}
+	a.providersMu.Lock()
 	a.providers = append(a.providers, provider)
+	a.providersMu.Unlock()
+}
+
+// ServiceProviders trả về danh sách service providers đã đăng ký.
+//
+// Implement Application interface method.
+//
+// Trả về:
+//   - []di.ServiceProvider: Bản sao danh sách providers theo thứ tự đăng ký
+func (a *application) ServiceProviders() []di.ServiceProvider {
+	a.providersMu.RLock()
+	defer a.providersMu.RUnlock()
+
+	providers := make([]di.ServiceProvider, len(a.providers))
+	copy(providers, a.providers)
+	return providers
+}
+
+// bootOrder trả về bản sao danh sách providers theo thứ tự boot.
+//
+// Trả về:
+//   - []di.ServiceProvider: sortedProviders nếu đã sắp xếp qua RegisterWithDependencies(),
+//     nếu không thì providers theo thứ tự đăng ký
+func (a *application) bootOrder() []di.ServiceProvider {
+	a.providersMu.RLock()
+	defer a.providersMu.RUnlock()
+
+	source := a.providers
+	if len(a.sortedProviders) > 0 {
+		source = a.sortedProviders
+	}
+	providers := make([]di.ServiceProvider, len(source))
+	copy(providers, source)
+	return providers
+}
+
+// providerLevels trả về bản sao dependency levels dùng cho parallel boot.
+//
+// Các levels không bị sửa tại chỗ sau khi được lưu nên chỉ slice ngoài được sao chép.
+//
+// Trả về:
+//   - [][]di.ServiceProvider: Providers nhóm theo dependency level, rỗng nếu chưa sắp xếp
+func (a *application) providerLevels() [][]di.ServiceProvider {
+	a.providersMu.RLock()
+	defer a.providersMu.RUnlock()
+
+	levels := make([][]di.ServiceProvider, len(a.bootLevels))
+	copy(levels, a.bootLevels)
+	return levels
 }
 
 // Boot khởi động tất cả service providers với smart dependency handling.
@@ -293,20 +797,39 @@ func (a *application) Register(provider di.ServiceProvider) {
 // Trả về:
 //   - error: Lỗi nếu registration hoặc boot thất bại
 func (a *application) Boot() error {
+	return a.BootContext(context.Background())
+}
+
+// BootContext khởi động tất cả service providers với context.
+//
+// Implement Application interface method. Workflow giống Boot() nhưng
+// context được truyền xuống cả bước đăng ký (dừng khi context bị hủy, spans
+// register là con của span trong ctx) và từng provider khi boot.
+//
+// Tham số:
+//   - ctx: context.Context - Context điều khiển quá trình boot
+//
+// Trả về:
+//   - error: Lỗi nếu registration hoặc boot thất bại, hoặc context bị hủy
+func (a *application) BootContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	// Kiểm tra xem có cần dependency-aware registration không
 	needsDependencyAware := a.hasDependencies()
 
 	var err error
 	if needsDependencyAware {
-		err = a.RegisterWithDependencies()
+		err = a.registerWithDependencies(ctx)
 	} else {
-		err = a.RegisterServiceProviders()
+		err = a.registerServiceProviders(ctx)
 	}
 
 	if err != nil {
 		return err
 	}
-	return a.BootServiceProviders()
+	return a.BootServiceProvidersContext(ctx)
 }
 
 // Bind đăng ký binding vào container.
@@ -317,6 +840,7 @@ func (a *application) Boot() error {
 //   - abstract: string - Abstract type name
 //   - concrete: di.BindingFunc - Factory function
 func (a *application) Bind(abstract string, concrete di.BindingFunc) {
+	a.auditBind(abstract)
 	a.container.Bind(abstract, concrete)
 }
 
@@ -328,6 +852,7 @@ func (a *application) Bind(abstract string, concrete di.BindingFunc) {
 //   - abstract: string - Abstract type name
 //   - concrete: di.BindingFunc - Factory function
 func (a *application) Singleton(abstract string, concrete di.BindingFunc) {
+	a.auditBind(abstract)
 	a.container.Singleton(abstract, concrete)
 }
 
@@ -339,6 +864,7 @@ func (a *application) Singleton(abstract string, concrete di.BindingFunc) {
 //   - abstract: string - Abstract type name
 //   - instance: interface{} - Instance object
 func (a *application) Instance(abstract string, instance interface{}) {
+	a.auditBind(abstract)
 	a.container.Instance(abstract, instance)
 }
 
@@ -350,6 +876,7 @@ func (a *application) Instance(abstract string, instance interface{}) {
 //   - abstract: string - Original abstract name
 //   - alias: string - Alias name
 func (a *application) Alias(abstract, alias string) {
+	a.auditBind(alias)
 	a.container.Alias(abstract, alias)
 }
 
@@ -364,7 +891,28 @@ func (a *application) Alias(abstract, alias string) {
 //   - interface{}: Resolved instance
 //   - error: Lỗi nếu resolve thất bại
 func (a *application) Make(abstract string) (interface{}, error) {
-	return a.container.Make(abstract)
+	return a.make(context.Background(), abstract)
+}
+
+// make resolve dependency từ container, ghi nhận metrics, audit và span.
+//
+// Tham số:
+//   - ctx: context.Context - Context chứa span cha của span resolve
+//   - abstract: string - Abstract type name
+//
+// Trả về:
+//   - interface{}: Resolved instance
+//   - error: Lỗi nếu resolve thất bại
+func (a *application) make(ctx context.Context, abstract string) (interface{}, error) {
+	done := a.observeResolution(abstract)
+	_, span := a.startSpan(ctx, SpanMake, "abstract", abstract)
+	instance, err := a.container.Make(abstract)
+	span.End(err)
+	done(err == nil)
+	if err == nil {
+		a.auditResolve(abstract)
+	}
+	return instance, err
 }
 
 // MustMake resolve dependency từ container, panic nếu lỗi.
@@ -377,7 +925,53 @@ func (a *application) Make(abstract string) (interface{}, error) {
 // Trả về:
 //   - interface{}: Resolved instance
 func (a *application) MustMake(abstract string) interface{} {
-	return a.container.MustMake(abstract)
+	done := a.observeResolution(abstract)
+	_, span := a.startSpan(context.Background(), SpanMake, "abstract", abstract)
+	succeeded := false
+	defer func() {
+		var err error
+		if !succeeded {
+			err = fmt.Errorf("failed to resolve '%s'", abstract)
+		}
+		span.End(err)
+		done(succeeded)
+	}()
+
+	instance := a.container.MustMake(abstract)
+	succeeded = true
+	a.auditResolve(abstract)
+	return instance
+}
+
+// MakeContext resolve dependency từ container với context.
+//
+// Implement Application interface method.
+//
+// Tham số:
+//   - ctx: context.Context - Context điều khiển việc resolve
+//   - abstract: string - Abstract type name
+//
+// Trả về:
+//   - interface{}: Resolved instance
+//   - error: Lỗi nếu resolve thất bại hoặc context bị hủy
+func (a *application) MakeContext(ctx context.Context, abstract string) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to resolve '%s': %w", abstract, err)
+	}
+
+	var instance interface{}
+	err := runWithContext(ctx, func() error {
+		resolved, err := a.make(ctx, abstract)
+		instance = resolved
+		return err
+	})
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil && err == ctxErr {
+			return nil, fmt.Errorf("failed to resolve '%s': %w", abstract, err)
+		}
+		return nil, err
+	}
+	return instance, nil
 }
 
 // Call gọi function với auto dependency injection.
@@ -392,7 +986,41 @@ func (a *application) MustMake(abstract string) interface{} {
 //   - []interface{}: Function return values
 //   - error: Lỗi nếu call thất bại
 func (a *application) Call(callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
-	return a.container.Call(callback, additionalParams...)
+	a.auditCall(callback)
+	results, err := a.container.Call(callback, additionalParams...)
+	if err == nil {
+		a.auditInjections(reflect.TypeOf(callback), additionalParams)
+	}
+	return results, err
+}
+
+// CallContext gọi function với auto dependency injection và context.
+//
+// Implement Application interface method.
+//
+// Tham số:
+//   - ctx: context.Context - Context điều khiển lời gọi
+//   - callback: interface{} - Function để gọi
+//   - additionalParams: ...interface{} - Additional parameters
+//
+// Trả về:
+//   - []interface{}: Function return values
+//   - error: Lỗi nếu call thất bại hoặc context bị hủy
+func (a *application) CallContext(ctx context.Context, callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var results []interface{}
+	err := runWithContext(ctx, func() error {
+		values, err := a.Call(callback, additionalParams...)
+		results = values
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
 }
 
 // hasDependencies kiểm tra xem có provider nào có dependencies không.
@@ -403,7 +1031,7 @@ func (a *application) Call(callback interface{}, additionalParams ...interface{}
 // Trả về:
 //   - bool: true nếu cần dependency-aware registration
 func (a *application) hasDependencies() bool {
-	for _, provider := range a.providers {
+	for _, provider := range a.ServiceProviders() {
 		if len(provider.Requires()) > 0 {
 			return true
 		}
@@ -426,76 +1054,16 @@ func getProviderKey(provider di.ServiceProvider) string {
 	return fmt.Sprintf("%s@%p", reflect.TypeOf(provider).String(), provider)
 }
 
-// topologicalSort sắp xếp providers theo dependency order.
+// providerName trả về tên dễ đọc của một service provider.
 //
-// Sử dụng Kahn's algorithm để detect cycles và sort providers.
+// Khác với getProviderKey, tên này không chứa memory address và được dùng
+// trong error messages và log.
 //
 // Tham số:
-//   - providerMap: map[string]di.ServiceProvider - Map provider key tới provider
-//   - serviceToProvider: map[string]string - Map service name tới provider key
+//   - provider: di.ServiceProvider - Provider cần lấy tên
 //
 // Trả về:
-//   - []di.ServiceProvider: Sorted providers list
-//   - error: Lỗi nếu có circular dependency hoặc missing dependency
-func (a *application) topologicalSort(providerMap map[string]di.ServiceProvider, serviceToProvider map[string]string) ([]di.ServiceProvider, error) {
-	// Build adjacency list và in-degree count
-	adjList := make(map[string][]string)
-	inDegree := make(map[string]int)
-
-	// Initialize all providers
-	for providerKey := range providerMap {
-		adjList[providerKey] = make([]string, 0)
-		inDegree[providerKey] = 0
-	}
-
-	// Build dependency graph
-	for providerKey, provider := range providerMap {
-		requires := provider.Requires()
-		for _, requiredService := range requires {
-			// Tìm provider cung cấp required service
-			if requiredProviderKey, exists := serviceToProvider[requiredService]; exists {
-				// requiredProvider -> currentProvider dependency
-				adjList[requiredProviderKey] = append(adjList[requiredProviderKey], providerKey)
-				inDegree[providerKey]++
-			} else {
-				return nil, fmt.Errorf("required service '%s' not provided by any registered provider (required by %s)", requiredService, providerKey)
-			}
-		}
-	}
-
-	// Kahn's algorithm
-	queue := make([]string, 0)
-	result := make([]di.ServiceProvider, 0)
-
-	// Find all providers với in-degree 0
-	for providerKey, degree := range inDegree {
-		if degree == 0 {
-			queue = append(queue, providerKey)
-		}
-	}
-
-	// Process queue
-	for len(queue) > 0 {
-		// Dequeue
-		current := queue[0]
-		queue = queue[1:]
-
-		// Add to result
-		result = append(result, providerMap[current])
-
-		// Update neighbors
-		for _, neighbor := range adjList[current] {
-			inDegree[neighbor]--
-			if inDegree[neighbor] == 0 {
-				queue = append(queue, neighbor)
-			}
-		}
-	}
-
-	// Check for cycles
-	if len(result) != len(providerMap) {
-		return nil, fmt.Errorf("circular dependency detected among service providers")
-	}
-
-	return result, nil
+//   - string: Type name của provider
+func providerName(provider di.ServiceProvider) string {
+	return reflect.TypeOf(provider).String()
 }
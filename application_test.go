@@ -326,6 +326,9 @@ func TestApplication_RegisterWithDependencies(t *testing.T) {
 		err := app.RegisterWithDependencies()
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "circular dependency detected")
+		// Cùng dependency graph với Plan(): lỗi nêu rõ chuỗi phụ thuộc vòng
+		assert.Contains(t, err.Error(), "*mocks.MockServiceProvider -> *mocks.MockServiceProvider -> *mocks.MockServiceProvider")
+		assert.Equal(t, core.ProblemCircularDependency, app.Plan().Errors()[0].Kind)
 	})
 
 	t.Run("missing_required_service_error", func(t *testing.T) {
@@ -0,0 +1,344 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"go.fork.vn/di"
+)
+
+// AuditReport chứa thống kê resolution của container khi audit mode được bật.
+//
+// Report giúp tìm bindings không bao giờ được resolve và providers có thể gỡ
+// bỏ. Resolutions qua Application (Make, MustMake, Config, Log), services được
+// inject vào Call/CallSafe và services mà binding factories resolve qua
+// container được ghi nhận. Service nằm trong Requires() của provider đang được
+// dùng cũng được coi là đang dùng. Bindings chỉ được ghi nhận khi đăng ký qua
+// Bind, Singleton, Instance, Alias của Application.
+type AuditReport struct {
+	// Resolutions là các services đã resolve, theo số lần giảm dần
+	Resolutions []AuditEntry
+	// Calls là các callbacks đã gọi qua Call, theo số lần giảm dần
+	Calls []AuditEntry
+	// UnusedServices là services khai báo trong Providers() nhưng chưa từng được resolve, theo thứ tự đăng ký
+	UnusedServices []UnusedService
+	// UnusedProviders là providers không có service nào được resolve, theo thứ tự đăng ký
+	UnusedProviders []string
+	// UnusedBindings là bindings đã đăng ký nhưng chưa từng được resolve, sắp xếp theo tên
+	UnusedBindings []string
+}
+
+// AuditEntry là số lần một service được resolve hoặc một callback được gọi.
+type AuditEntry struct {
+	// Name là abstract của service, hoặc type của callback với Call
+	Name string
+	// Count là tổng số lần
+	Count int
+	// Callers là các vị trí gọi, theo số lần giảm dần
+	Callers []AuditCaller
+}
+
+// AuditCaller là một vị trí gọi ngoài package core.
+type AuditCaller struct {
+	// Caller là vị trí gọi dạng "file:line", rỗng nếu không xác định được
+	Caller string
+	// Count là số lần gọi từ vị trí này
+	Count int
+}
+
+// UnusedService là service của provider chưa từng được resolve.
+type UnusedService struct {
+	// Provider là tên (type) của provider khai báo service
+	Provider string
+	// Service là tên service
+	Service string
+}
+
+// Table trả về report dưới dạng bảng text dễ đọc.
+//
+// Trả về:
+//   - string: Bảng resolutions, calls và danh sách bindings chưa dùng
+func (r *AuditReport) Table() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "audit report: resolutions=%d calls=%d unused services=%d unused bindings=%d\n",
+		len(r.Resolutions), len(r.Calls), len(r.UnusedServices), len(r.UnusedBindings))
+
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KIND\tNAME\tCOUNT\tTOP CALLER")
+	for _, entries := range []struct {
+		kind    string
+		entries []AuditEntry
+	}{{"make", r.Resolutions}, {"call", r.Calls}} {
+		for _, entry := range entries.entries {
+			caller := ""
+			if len(entry.Callers) > 0 {
+				caller = entry.Callers[0].Caller
+			}
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", entries.kind, entry.Name, entry.Count, caller)
+		}
+	}
+	w.Flush()
+
+	for _, unused := range r.UnusedServices {
+		fmt.Fprintf(&b, "unused service: %s (%s)\n", unused.Service, unused.Provider)
+	}
+	for _, provider := range r.UnusedProviders {
+		fmt.Fprintf(&b, "unused provider: %s\n", provider)
+	}
+	for _, binding := range r.UnusedBindings {
+		fmt.Fprintf(&b, "unused binding: %s\n", binding)
+	}
+
+	return b.String()
+}
+
+// auditLog ghi nhận resolutions, calls và bindings khi audit mode được bật.
+//
+// An toàn khi dùng đồng thời từ nhiều goroutines.
+type auditLog struct {
+	mu          sync.Mutex
+	resolutions map[string]map[string]int
+	calls       map[string]map[string]int
+	bindings    map[string]bool
+}
+
+// newAuditLog tạo audit log rỗng.
+//
+// Trả về:
+//   - *auditLog: Audit log instance
+func newAuditLog() *auditLog {
+	return &auditLog{
+		resolutions: make(map[string]map[string]int),
+		calls:       make(map[string]map[string]int),
+		bindings:    make(map[string]bool),
+	}
+}
+
+// EnableAudit bật audit mode.
+//
+// Implement Application interface method. Gọi nhiều lần không xóa dữ liệu đã ghi nhận.
+func (a *application) EnableAudit() {
+	a.audit.CompareAndSwap(nil, newAuditLog())
+}
+
+// AuditReport trả về thống kê resolution của container.
+//
+// Implement Application interface method.
+//
+// Trả về:
+//   - *AuditReport: Snapshot của report tại thời điểm gọi, nil nếu audit mode chưa bật
+func (a *application) AuditReport() *AuditReport {
+	audit := a.audit.Load()
+	if audit == nil {
+		return nil
+	}
+
+	audit.mu.Lock()
+	defer audit.mu.Unlock()
+
+	report := &AuditReport{
+		Resolutions:     auditEntries(audit.resolutions),
+		Calls:           auditEntries(audit.calls),
+		UnusedServices:  make([]UnusedService, 0),
+		UnusedProviders: make([]string, 0),
+		UnusedBindings:  make([]string, 0),
+	}
+
+	providers := make([]di.ServiceProvider, 0)
+	seen := make(map[string]bool)
+	for _, provider := range a.ServiceProviders() {
+		key := getProviderKey(provider)
+		if seen[key] || len(provider.Providers()) == 0 {
+			continue
+		}
+		seen[key] = true
+		providers = append(providers, provider)
+	}
+	used := usedServices(providers, audit.resolutions)
+
+	for _, provider := range providers {
+		providerUsed := false
+		for _, service := range provider.Providers() {
+			if used[service] {
+				providerUsed = true
+				continue
+			}
+			report.UnusedServices = append(report.UnusedServices, UnusedService{Provider: providerName(provider), Service: service})
+		}
+		if !providerUsed {
+			report.UnusedProviders = append(report.UnusedProviders, providerName(provider))
+		}
+	}
+
+	for binding := range audit.bindings {
+		if !used[binding] {
+			report.UnusedBindings = append(report.UnusedBindings, binding)
+		}
+	}
+	sort.Strings(report.UnusedBindings)
+
+	return report
+}
+
+// usedServices tìm các services đang được dùng.
+//
+// Service được dùng nếu đã được resolve, hoặc nằm trong Requires() của một
+// provider có service được dùng.
+//
+// Tham số:
+//   - providers: []di.ServiceProvider - Providers đã đăng ký, không trùng lặp
+//   - resolutions: map[string]map[string]int - Bảng đếm resolutions
+//
+// Trả về:
+//   - map[string]bool: Services được dùng
+func usedServices(providers []di.ServiceProvider, resolutions map[string]map[string]int) map[string]bool {
+	used := make(map[string]bool, len(resolutions))
+	for service, callers := range resolutions {
+		if len(callers) > 0 {
+			used[service] = true
+		}
+	}
+
+	expanded := make(map[string]bool)
+	for changed := true; changed; {
+		changed = false
+		for _, provider := range providers {
+			key := getProviderKey(provider)
+			if expanded[key] || !providesAny(provider, used) {
+				continue
+			}
+			expanded[key] = true
+			changed = true
+			for _, service := range provider.Requires() {
+				used[service] = true
+			}
+		}
+	}
+	return used
+}
+
+// providesAny kiểm tra provider có cung cấp một trong các services.
+//
+// Tham số:
+//   - provider: di.ServiceProvider - Provider cần kiểm tra
+//   - services: map[string]bool - Services cần tìm
+//
+// Trả về:
+//   - bool: true nếu provider cung cấp ít nhất một service
+func providesAny(provider di.ServiceProvider, services map[string]bool) bool {
+	for _, service := range provider.Providers() {
+		if services[service] {
+			return true
+		}
+	}
+	return false
+}
+
+// auditResolve ghi nhận một lần resolve service nếu audit mode được bật.
+//
+// Tham số:
+//   - abstract: string - Tên service đã resolve
+func (a *application) auditResolve(abstract string) {
+	if audit := a.audit.Load(); audit != nil {
+		audit.record(audit.resolutions, abstract, auditCaller())
+	}
+}
+
+// auditCall ghi nhận một lần Call nếu audit mode được bật.
+//
+// Tham số:
+//   - callback: interface{} - Callback được gọi
+func (a *application) auditCall(callback interface{}) {
+	if audit := a.audit.Load(); audit != nil {
+		audit.record(audit.calls, fmt.Sprintf("%T", callback), auditCaller())
+	}
+}
+
+// auditBind ghi nhận một binding nếu audit mode được bật.
+//
+// Tham số:
+//   - abstract: string - Tên binding
+func (a *application) auditBind(abstract string) {
+	if audit := a.audit.Load(); audit != nil {
+		audit.mu.Lock()
+		defer audit.mu.Unlock()
+		audit.bindings[abstract] = true
+	}
+}
+
+// record tăng số lần của name từ caller.
+//
+// Tham số:
+//   - counts: map[string]map[string]int - Bảng đếm theo name và caller
+//   - name: string - Abstract hoặc type của callback
+//   - caller: string - Vị trí gọi
+func (l *auditLog) record(counts map[string]map[string]int, name, caller string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	callers, exists := counts[name]
+	if !exists {
+		callers = make(map[string]int)
+		counts[name] = callers
+	}
+	callers[caller]++
+}
+
+// auditEntries chuyển bảng đếm thành entries theo số lần giảm dần, tên tăng dần.
+//
+// Tham số:
+//   - counts: map[string]map[string]int - Bảng đếm theo name và caller
+//
+// Trả về:
+//   - []AuditEntry: Entries đã sắp xếp
+func auditEntries(counts map[string]map[string]int) []AuditEntry {
+	entries := make([]AuditEntry, 0, len(counts))
+	for name, callers := range counts {
+		entry := AuditEntry{Name: name, Callers: make([]AuditCaller, 0, len(callers))}
+		for caller, count := range callers {
+			entry.Count += count
+			entry.Callers = append(entry.Callers, AuditCaller{Caller: caller, Count: count})
+		}
+		sort.Slice(entry.Callers, func(i, j int) bool {
+			if entry.Callers[i].Count != entry.Callers[j].Count {
+				return entry.Callers[i].Count > entry.Callers[j].Count
+			}
+			return entry.Callers[i].Caller < entry.Callers[j].Caller
+		})
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries
+}
+
+// corePackage là prefix tên functions của package core, dùng để bỏ qua frames nội bộ.
+var corePackage = reflect.TypeOf((*application)(nil)).Elem().PkgPath() + "."
+
+// auditCaller tìm vị trí gọi đầu tiên ngoài package core.
+//
+// Trả về:
+//   - string: Vị trí dạng "file:line", rỗng nếu không xác định được
+func auditCaller() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, corePackage) && frame.Function != "" {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package core_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+	"go.fork.vn/di"
+)
+
+// TestApplication_Audit tests resolution audit and unused binding detection
+func TestApplication_Audit(t *testing.T) {
+	t.Run("returns_nil_when_disabled", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Instance("cache", "redis")
+		app.MustMake("cache")
+
+		assert.Nil(t, app.AuditReport())
+	})
+
+	t.Run("counts_resolutions_with_callers", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.EnableAudit()
+		app.Instance("cache", "redis")
+		app.Instance("mailer", "smtp")
+
+		for i := 0; i < 3; i++ {
+			app.MustMake("cache")
+		}
+		_, err := app.Make("mailer")
+		require.NoError(t, err)
+		_, err = app.Make("missing")
+		require.Error(t, err)
+
+		report := app.AuditReport()
+		require.Len(t, report.Resolutions, 2)
+		assert.Equal(t, "cache", report.Resolutions[0].Name)
+		assert.Equal(t, 3, report.Resolutions[0].Count)
+		require.Len(t, report.Resolutions[0].Callers, 1)
+		assert.Contains(t, report.Resolutions[0].Callers[0].Caller, "audit_test.go:")
+		assert.Equal(t, "mailer", report.Resolutions[1].Name)
+		assert.Equal(t, 1, report.Resolutions[1].Count)
+	})
+
+	t.Run("records_calls", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.EnableAudit()
+
+		_, err := app.Call(func() string { return "done" })
+		require.NoError(t, err)
+
+		report := app.AuditReport()
+		require.Len(t, report.Calls, 1)
+		assert.Equal(t, "func() string", report.Calls[0].Name)
+		assert.Equal(t, 1, report.Calls[0].Count)
+	})
+
+	t.Run("records_resolutions_inside_singleton_factories", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.EnableAudit()
+		app.Instance("database", "primary")
+		app.Singleton("repository", func(c di.Container) interface{} {
+			return "repository on " + c.MustMake("database").(string)
+		})
+
+		app.MustMake("repository")
+
+		report := app.AuditReport()
+		require.Len(t, report.Resolutions, 2)
+		assert.Equal(t, "database", report.Resolutions[0].Name)
+		require.Len(t, report.Resolutions[0].Callers, 1)
+		assert.Contains(t, report.Resolutions[0].Callers[0].Caller, "audit_test.go:")
+		assert.Equal(t, "repository", report.Resolutions[1].Name)
+		assert.Empty(t, report.UnusedBindings)
+	})
+
+	t.Run("records_injected_parameters", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.EnableAudit()
+		app.Instance("*strings.Builder", &strings.Builder{})
+		app.Instance("*strings.Reader", strings.NewReader("unused"))
+
+		_, err := app.Call(func(b *strings.Builder) {})
+		require.NoError(t, err)
+		_, err = app.CallSafe(func(name string, b *strings.Builder) {}, "job")
+		require.NoError(t, err)
+
+		report := app.AuditReport()
+		require.Len(t, report.Resolutions, 1)
+		assert.Equal(t, "*strings.Builder", report.Resolutions[0].Name)
+		assert.Equal(t, 2, report.Resolutions[0].Count)
+		assert.Equal(t, []string{"*strings.Reader"}, report.UnusedBindings)
+	})
+
+	t.Run("lists_unused_services_providers_and_bindings", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.EnableAudit()
+		app.Register(newBindingProvider("database", "primary"))
+		app.Register(newBindingProvider("repository", "repository", "database"))
+		app.Register(newBindingProvider("mailer", "smtp"))
+		require.NoError(t, app.Boot())
+		app.Instance("cache", "redis")
+
+		app.MustMake("repository")
+
+		report := app.AuditReport()
+		assert.Equal(t, []core.UnusedService{
+			{Provider: "*core_test.bindingProvider", Service: "mailer"},
+		}, report.UnusedServices)
+		assert.Equal(t, []string{"*core_test.bindingProvider"}, report.UnusedProviders)
+		assert.Equal(t, []string{"cache", "mailer"}, report.UnusedBindings)
+
+		table := report.Table()
+		assert.True(t, strings.HasPrefix(table, "audit report: resolutions=1 calls=0 unused services=1 unused bindings=2"))
+		assert.Contains(t, table, "unused service: mailer (*core_test.bindingProvider)")
+		assert.Contains(t, table, "unused binding: cache")
+	})
+}
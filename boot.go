@@ -0,0 +1,411 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"go.fork.vn/config"
+	"go.fork.vn/di"
+)
+
+// ContextBooter là interface tùy chọn cho service provider cần boot với context.
+//
+// Provider implement interface này sẽ được gọi BootContext thay cho Boot khi
+// application boot, nhờ đó provider có thể:
+//   - Dừng các thao tác chậm (network dial, migration...) khi context bị hủy
+//   - Trả về lỗi boot thay vì panic
+//
+// Context truyền vào đã bao gồm timeout boot cho từng provider nếu được
+// cấu hình qua key "app.boot.timeout".
+type ContextBooter interface {
+	// BootContext boot provider với context có thể bị hủy.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context boot, bị hủy khi timeout hoặc caller cancel
+	//   - app: di.Application - Application instance
+	//
+	// Trả về:
+	//   - error: Lỗi nếu boot thất bại
+	BootContext(ctx context.Context, app di.Application) error
+}
+
+// BootTimeoutError represent lỗi khi một provider không boot xong trong thời gian cho phép.
+//
+// Error type này cho biết chính xác provider nào bị treo, giúp debug các
+// provider chờ network hoặc resource bên ngoài trong quá trình startup.
+type BootTimeoutError struct {
+	// Provider là tên (type) của provider bị timeout
+	Provider string
+	// Timeout là thời gian boot tối đa đã cấu hình qua "app.boot.timeout"
+	Timeout time.Duration
+}
+
+// Error implement error interface.
+//
+// Trả về:
+//   - string: Error message với tên provider bị timeout
+func (e *BootTimeoutError) Error() string {
+	return fmt.Sprintf("service provider %s did not finish booting within %s", e.Provider, e.Timeout)
+}
+
+// Unwrap trả về context.DeadlineExceeded để hỗ trợ errors.Is.
+func (e *BootTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// ProviderBootError represent lỗi trả về từ quá trình boot của một provider.
+//
+// Error type này bọc lỗi gốc (lỗi từ BootContext, lỗi context của caller hoặc
+// panic) cùng với tên provider gây lỗi.
+type ProviderBootError struct {
+	// Provider là tên (type) của provider boot thất bại
+	Provider string
+	// Err là lỗi gốc
+	Err error
+}
+
+// Error implement error interface.
+//
+// Trả về:
+//   - string: Error message với tên provider và lỗi gốc
+func (e *ProviderBootError) Error() string {
+	return fmt.Sprintf("failed to boot service provider %s: %v", e.Provider, e.Err)
+}
+
+// Unwrap trả về lỗi gốc để hỗ trợ errors.Is và errors.As.
+func (e *ProviderBootError) Unwrap() error {
+	return e.Err
+}
+
+// bootOptions chứa các tùy chọn boot đọc từ config.
+//
+// Fields:
+//   - timeout: Thời gian boot tối đa cho mỗi provider, 0 nếu không giới hạn
+//   - parallel: Boot đồng thời các providers trong cùng dependency level
+//   - workers: Số providers tối đa boot đồng thời trong parallel mode
+type bootOptions struct {
+	timeout  time.Duration
+	parallel bool
+	workers  int
+}
+
+// bootOptions đọc các tùy chọn boot từ config.
+//
+// Các key được hỗ trợ:
+//   - "app.boot.timeout": Duration string (ví dụ: "30s", "500ms"), timeout cho mỗi provider
+//   - "app.boot.parallel": Bật parallel boot mode (mặc định false)
+//   - "app.boot.workers": Số worker cho parallel mode (mặc định runtime.GOMAXPROCS(0))
+//
+// Giá trị không hợp lệ hoặc config manager chưa được đăng ký sẽ dùng giá trị mặc định:
+// sequential mode, không giới hạn thời gian.
+//
+// Trả về:
+//   - bootOptions: Tùy chọn boot
+func (a *application) bootOptions() bootOptions {
+	options := bootOptions{workers: runtime.GOMAXPROCS(0)}
+
+	cfg, ok := a.configManager()
+	if !ok {
+		return options
+	}
+
+	if value, ok := cfg.GetString("app.boot.timeout"); ok && value != "" {
+		if timeout, err := time.ParseDuration(value); err == nil && timeout > 0 {
+			options.timeout = timeout
+		}
+	}
+	if parallel, ok := cfg.GetBool("app.boot.parallel"); ok {
+		options.parallel = parallel
+	}
+	if workers, ok := cfg.GetInt("app.boot.workers"); ok && workers > 0 {
+		options.workers = workers
+	}
+
+	return options
+}
+
+// configManager trả về config manager nếu đã được đăng ký vào container.
+//
+// Khác với Config(), phương thức này không panic khi config manager chưa
+// được đăng ký, phù hợp cho các tùy chọn đọc từ config trong lifecycle.
+//
+// Trả về:
+//   - config.Manager: Config manager instance
+//   - bool: true nếu config manager khả dụng
+func (a *application) configManager() (config.Manager, bool) {
+	instance, err := a.container.Make("config")
+	if err != nil {
+		return nil, false
+	}
+	cfg, ok := instance.(config.Manager)
+	return cfg, ok
+}
+
+// bootProvider boot một provider với context và timeout.
+//
+// Provider implement ContextBooter được gọi BootContext, các provider khác
+// được gọi Boot. Panic trong Boot/BootContext được trả về dưới dạng
+// ProviderBootError ở cả chế độ boot tuần tự và song song. Khi context không thể bị hủy và không có timeout, provider
+// được boot đồng bộ trên goroutine hiện tại. Ngược lại provider được boot
+// trên goroutine riêng để có thể trả về ngay khi context hết hạn; goroutine
+// của provider bị treo sẽ tiếp tục chạy cho tới khi provider tự kết thúc.
+//
+// Tham số:
+//   - ctx: context.Context - Context boot
+//   - app: di.Application - Application truyền vào Boot/BootContext
+//   - provider: di.ServiceProvider - Provider cần boot
+//   - timeout: time.Duration - Timeout cho provider, 0 nếu không giới hạn
+//
+// Trả về:
+//   - error: BootTimeoutError, ProviderBootError hoặc nil
+func (a *application) bootProvider(ctx context.Context, app di.Application, provider di.ServiceProvider, timeout time.Duration) error {
+	name := providerName(provider)
+	if a.recorder.isBooted(provider) {
+		a.logEvent("debug", "provider.boot.skipped", "provider", name, "reason", "already booted")
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		err = &ProviderBootError{Provider: name, Err: err}
+		a.logEvent("error", "provider.boot.failed", "provider", name, "duration", time.Duration(0), "error", err)
+		return err
+	}
+
+	ctx, span := a.startSpan(ctx, SpanProviderBoot, "provider", name)
+	bootCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		bootCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	a.logEvent("debug", "provider.boot.start", "provider", name)
+	var err error
+	elapsed, bytes, allocs := measure(func() {
+		err = runWithContext(bootCtx, func() (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = fmt.Errorf("panic: %v", r)
+				}
+			}()
+			if booter, ok := provider.(ContextBooter); ok {
+				return booter.BootContext(bootCtx, app)
+			}
+			provider.Boot(app)
+			return nil
+		})
+	}, a.trackAllocations())
+	a.recorder.recordBoot(provider, elapsed, bytes, allocs, err == nil)
+	a.recordProviderBoot(provider, elapsed, err == nil)
+
+	if err == nil {
+		span.End(nil)
+		a.logEvent("info", "provider.boot.end", "provider", name, "duration", elapsed)
+		return nil
+	}
+	if ctxErr := bootCtx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+		err = bootContextError(name, timeout, ctx.Err(), ctxErr)
+	} else {
+		err = &ProviderBootError{Provider: name, Err: err}
+	}
+	span.End(err)
+	a.logEvent("error", "provider.boot.failed", "provider", name, "duration", elapsed, "error", err)
+	return err
+}
+
+// bootSequential boot lần lượt từng provider theo thứ tự cho trước.
+//
+// Dừng lại ở provider đầu tiên boot thất bại; các providers phía sau không được
+// boot và được log là skipped.
+//
+// Tham số:
+//   - ctx: context.Context - Context boot
+//   - providers: []di.ServiceProvider - Providers theo thứ tự boot
+//   - options: bootOptions - Tùy chọn boot
+//
+// Trả về:
+//   - error: Lỗi của provider đầu tiên boot thất bại
+func (a *application) bootSequential(ctx context.Context, providers []di.ServiceProvider, options bootOptions) error {
+	for i, provider := range providers {
+		if err := a.bootProvider(ctx, a, provider, options.timeout); err != nil {
+			a.logSkippedProviders(providers[i+1:], err)
+			return err
+		}
+	}
+	return nil
+}
+
+// BootServicesContext chỉ boot các providers cung cấp services chỉ định và dependencies của chúng.
+//
+// Implement Application interface method.
+//
+// Providers được boot tuần tự theo thứ tự dependency. Trạng thái lifecycle
+// chỉ thay đổi khi boot thất bại (StateFailed); application chỉ chuyển sang
+// StateBooted sau BootServiceProviders().
+//
+// Tham số:
+//   - ctx: context.Context - Context điều khiển quá trình boot
+//   - services: ...string - Tên services cần sẵn sàng
+//
+// Trả về:
+//   - error: Lỗi nếu service không có provider hoặc boot thất bại
+func (a *application) BootServicesContext(ctx context.Context, services ...string) error {
+	if len(services) == 0 {
+		return nil
+	}
+
+	providers := a.bootOrder()
+	graph := newProviderGraph(a.ServiceProviders())
+	needed := make(map[string]bool)
+	var pending []string
+	for _, service := range services {
+		key, exists := graph.services[service]
+		if !exists {
+			// Services đã có sẵn trong container (ví dụ "config") không cần boot
+			if _, err := a.container.Make(service); err == nil {
+				continue
+			}
+			return fmt.Errorf("service '%s' not provided by any registered provider", service)
+		}
+		pending = append(pending, key)
+	}
+	for len(pending) > 0 {
+		key := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if needed[key] {
+			continue
+		}
+		needed[key] = true
+		pending = append(pending, graph.dependsOn[key]...)
+	}
+
+	subset := make([]di.ServiceProvider, 0, len(needed))
+	for _, provider := range providers {
+		if needed[getProviderKey(provider)] {
+			subset = append(subset, provider)
+		}
+	}
+
+	if err := a.bootSequential(ctx, subset, a.bootOptions()); err != nil {
+		a.setState(StateFailed)
+		return err
+	}
+	return nil
+}
+
+// bootParallel boot các providers theo từng dependency level.
+//
+// Providers trong cùng một level không phụ thuộc lẫn nhau nên được boot đồng
+// thời với tối đa options.workers goroutines. Một level chỉ bắt đầu khi toàn
+// bộ level trước đã boot xong, nên mọi provider luôn boot sau các dependencies
+// của nó. Khi có provider boot thất bại, các providers đang boot trong cùng
+// level vẫn được chờ hoàn tất, các level sau không được boot và lỗi của
+// provider đứng đầu (theo thứ tự đăng ký) trong level được trả về.
+//
+// Tham số:
+//   - ctx: context.Context - Context boot
+//   - levels: [][]di.ServiceProvider - Providers nhóm theo dependency level
+//   - options: bootOptions - Tùy chọn boot
+//
+// Trả về:
+//   - error: Lỗi boot đầu tiên theo thứ tự dependency
+func (a *application) bootParallel(ctx context.Context, levels [][]di.ServiceProvider, options bootOptions) error {
+	workers := options.workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	for depth, level := range levels {
+		errs := make([]error, len(level))
+		semaphore := make(chan struct{}, workers)
+		var wg sync.WaitGroup
+
+		for i, provider := range level {
+			wg.Add(1)
+			semaphore <- struct{}{}
+
+			go func(i int, provider di.ServiceProvider) {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+
+				errs[i] = a.bootProvider(ctx, a, provider, options.timeout)
+			}(i, provider)
+		}
+
+		wg.Wait()
+
+		for _, err := range errs {
+			if err != nil {
+				for _, skipped := range levels[depth+1:] {
+					a.logSkippedProviders(skipped, err)
+				}
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// bootContextError chuyển lỗi context thành error type tương ứng.
+//
+// BootTimeoutError chỉ được trả về khi timer của provider hết hạn; deadline
+// hoặc cancel từ context của caller được bọc trong ProviderBootError.
+//
+// Tham số:
+//   - name: string - Tên provider
+//   - timeout: time.Duration - Timeout đã cấu hình cho provider
+//   - parentErr: error - Lỗi từ context của caller, nil nếu context vẫn còn hiệu lực
+//   - err: error - Lỗi từ context boot của provider
+//
+// Trả về:
+//   - error: BootTimeoutError nếu timeout của provider hết hạn, ProviderBootError nếu không
+func bootContextError(name string, timeout time.Duration, parentErr, err error) error {
+	if parentErr != nil {
+		return &ProviderBootError{Provider: name, Err: parentErr}
+	}
+	if timeout > 0 && err == context.DeadlineExceeded {
+		return &BootTimeoutError{Provider: name, Timeout: timeout}
+	}
+	return &ProviderBootError{Provider: name, Err: err}
+}
+
+// runWithContext chạy fn và trả về sớm khi context bị hủy.
+//
+// Nếu ctx không thể bị hủy (ctx.Done() == nil), fn được gọi đồng bộ và
+// panic được giữ nguyên. Ngược lại fn chạy trên goroutine riêng, panic
+// được chuyển thành error và ctx.Err() được trả về nếu context bị hủy
+// trước khi fn kết thúc.
+//
+// Tham số:
+//   - ctx: context.Context - Context điều khiển
+//   - fn: func() error - Hàm cần chạy
+//
+// Trả về:
+//   - error: Lỗi từ fn hoặc ctx.Err()
+func runWithContext(ctx context.Context, fn func() error) error {
+	if ctx.Done() == nil {
+		return fn()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("panic: %v", r)
+			}
+		}()
+		done <- fn()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
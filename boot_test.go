@@ -0,0 +1,611 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/config"
+	"go.fork.vn/core"
+	"go.fork.vn/di"
+	diMocks "go.fork.vn/di/mocks"
+)
+
+// contextProvider là service provider test implement core.ContextBooter.
+type contextProvider struct {
+	provides []string
+	requires []string
+	boot     func(ctx context.Context, app di.Application) error
+}
+
+func (p *contextProvider) Register(app di.Application) {}
+
+func (p *contextProvider) Boot(app di.Application) {
+	panic("Boot must not be called on a ContextBooter")
+}
+
+func (p *contextProvider) Requires() []string {
+	return p.requires
+}
+
+func (p *contextProvider) Providers() []string {
+	return p.provides
+}
+
+func (p *contextProvider) BootContext(ctx context.Context, app di.Application) error {
+	return p.boot(ctx, app)
+}
+
+// newConfiguredApp tạo application với config manager đã đăng ký và các giá trị config cho trước.
+func newConfiguredApp(t *testing.T, values map[string]interface{}) core.Application {
+	t.Helper()
+
+	app := core.New(map[string]interface{}{})
+	config.NewServiceProvider().Register(app)
+	for key, value := range values {
+		app.Config().Set(key, value)
+	}
+	return app
+}
+
+// TestApplication_BootContext tests context-aware booting
+func TestApplication_BootContext(t *testing.T) {
+	t.Run("passes_context_to_context_booter", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		type ctxKey struct{}
+		ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+		var received interface{}
+		app.Register(&contextProvider{
+			provides: []string{"service.a"},
+			boot: func(ctx context.Context, app di.Application) error {
+				received = ctx.Value(ctxKey{})
+				return nil
+			},
+		})
+
+		err := app.BootContext(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, "value", received)
+	})
+
+	t.Run("returns_provider_boot_error", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		bootErr := errors.New("dial tcp: connection refused")
+
+		app.Register(&contextProvider{
+			provides: []string{"database"},
+			boot: func(ctx context.Context, app di.Application) error {
+				return bootErr
+			},
+		})
+
+		err := app.BootContext(context.Background())
+		require.Error(t, err)
+
+		var providerErr *core.ProviderBootError
+		require.True(t, errors.As(err, &providerErr))
+		assert.Equal(t, "*core_test.contextProvider", providerErr.Provider)
+		assert.ErrorIs(t, err, bootErr)
+	})
+
+	t.Run("fails_fast_when_context_already_cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		provider := diMocks.NewMockServiceProvider(t)
+		provider.EXPECT().Providers().Return([]string{"service"}).Maybe()
+		provider.EXPECT().Requires().Return([]string{}).Maybe()
+
+		app.Register(provider)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := app.BootContext(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("stops_registration_when_context_cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		registered := make([]string, 0)
+		app := core.New(map[string]interface{}{})
+		app.Register(&registeringProvider{
+			lifecycleProvider: *newLifecycleProvider("database"),
+			register: func(app di.Application) {
+				registered = append(registered, "database")
+				app.Instance("database", "primary")
+				cancel()
+			},
+		})
+		app.Register(&registeringProvider{
+			lifecycleProvider: *newLifecycleProvider("repository", "database"),
+			register: func(app di.Application) {
+				registered = append(registered, "repository")
+				app.Instance("repository", "repository")
+			},
+		})
+
+		err := app.BootContext(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, []string{"database"}, registered)
+	})
+
+	t.Run("names_stuck_provider_when_caller_deadline_exceeded", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		release := make(chan struct{})
+		defer close(release)
+
+		// Provider bỏ qua context và chỉ kết thúc khi test kết thúc
+		app.Register(&contextProvider{
+			provides: []string{"service"},
+			boot: func(ctx context.Context, app di.Application) error {
+				<-release
+				return nil
+			},
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := app.BootContext(ctx)
+		require.Error(t, err)
+
+		// Deadline đến từ caller, không phải từ timeout của provider
+		var timeoutErr *core.BootTimeoutError
+		assert.False(t, errors.As(err, &timeoutErr))
+
+		var bootErr *core.ProviderBootError
+		require.True(t, errors.As(err, &bootErr))
+		assert.Equal(t, "*core_test.contextProvider", bootErr.Provider)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("reports_caller_deadline_within_provider_timeout", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{
+			"app.boot.timeout": "1m",
+		})
+		app.Register(&contextProvider{
+			provides: []string{"service"},
+			boot: func(ctx context.Context, app di.Application) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		})
+		require.NoError(t, app.RegisterWithDependencies())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		err := app.BootServiceProvidersContext(ctx)
+		require.Error(t, err)
+
+		var timeoutErr *core.BootTimeoutError
+		assert.False(t, errors.As(err, &timeoutErr))
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.NotContains(t, err.Error(), "1m")
+	})
+}
+
+// TestApplication_BootServiceProvidersContext tests per-provider boot timeouts
+func TestApplication_BootServiceProvidersContext(t *testing.T) {
+	t.Run("applies_boot_timeout_from_config", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{
+			"app.boot.timeout": "20ms",
+		})
+
+		app.Register(&contextProvider{
+			provides: []string{"database"},
+			boot: func(ctx context.Context, app di.Application) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		})
+
+		require.NoError(t, app.RegisterWithDependencies())
+
+		err := app.BootServiceProvidersContext(context.Background())
+		require.Error(t, err)
+
+		var timeoutErr *core.BootTimeoutError
+		require.True(t, errors.As(err, &timeoutErr))
+		assert.Equal(t, 20*time.Millisecond, timeoutErr.Timeout)
+		assert.Contains(t, err.Error(), "*core_test.contextProvider")
+		assert.Contains(t, err.Error(), "20ms")
+	})
+
+	t.Run("ignores_invalid_boot_timeout", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{
+			"app.boot.timeout": "not-a-duration",
+		})
+
+		app.Register(&contextProvider{
+			provides: []string{"service"},
+			boot: func(ctx context.Context, app di.Application) error {
+				_, hasDeadline := ctx.Deadline()
+				assert.False(t, hasDeadline)
+				return nil
+			},
+		})
+
+		require.NoError(t, app.RegisterWithDependencies())
+		assert.NoError(t, app.BootServiceProvidersContext(context.Background()))
+	})
+
+	t.Run("does_not_boot_remaining_providers_after_failure", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		app.Register(&contextProvider{
+			provides: []string{"service.a"},
+			boot: func(ctx context.Context, app di.Application) error {
+				return errors.New("boot failed")
+			},
+		})
+
+		providerB := diMocks.NewMockServiceProvider(t)
+		providerB.EXPECT().Providers().Return([]string{"service.b"}).Maybe()
+		providerB.EXPECT().Requires().Return([]string{"service.a"}).Maybe()
+		providerB.EXPECT().Register(app).Once()
+
+		app.Register(providerB)
+
+		require.NoError(t, app.RegisterWithDependencies())
+
+		err := app.BootServiceProvidersContext(context.Background())
+		assert.Error(t, err)
+		providerB.AssertNotCalled(t, "Boot", app)
+	})
+}
+
+// TestApplication_MakeContext tests context-aware resolution
+func TestApplication_MakeContext(t *testing.T) {
+	t.Run("resolves_binding", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Bind("test.service", func(c di.Container) interface{} {
+			return "test-value"
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		service, err := app.MakeContext(ctx, "test.service")
+		assert.NoError(t, err)
+		assert.Equal(t, "test-value", service)
+	})
+
+	t.Run("returns_error_for_missing_binding", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		_, err := app.MakeContext(context.Background(), "missing.service")
+		assert.Error(t, err)
+	})
+
+	t.Run("returns_when_factory_hangs", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		release := make(chan struct{})
+		defer close(release)
+
+		app.Singleton("slow.service", func(c di.Container) interface{} {
+			<-release
+			return "slow"
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := app.MakeContext(ctx, "slow.service")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "slow.service")
+	})
+
+	t.Run("fails_fast_when_context_already_cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Bind("test.service", func(c di.Container) interface{} {
+			t.Error("factory must not be called")
+			return nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := app.MakeContext(ctx, "test.service")
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+// TestApplication_CallContext tests context-aware calls
+func TestApplication_CallContext(t *testing.T) {
+	t.Run("calls_function_with_parameters", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Bind("string", func(c di.Container) interface{} {
+			return "injected-value"
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		result, err := app.CallContext(ctx, func(param string) string {
+			return "result: " + param
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"result: injected-value"}, result)
+	})
+
+	t.Run("returns_when_callback_hangs", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		release := make(chan struct{})
+		defer close(release)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		_, err := app.CallContext(ctx, func() {
+			<-release
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+// TestModuleLoader_BootstrapApplicationContext tests context-aware bootstrapping
+func TestModuleLoader_BootstrapApplicationContext(t *testing.T) {
+	setupTestEnvironment(t)
+
+	t.Run("fails_fast_when_context_already_cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{
+			"file": "testdata/configs/console-only-simple.yaml",
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := app.ModuleLoader().BootstrapApplicationContext(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("propagates_context_to_providers", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{
+			"file": "testdata/configs/console-only-simple.yaml",
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		var hasDeadline bool
+		app.Register(&contextProvider{
+			provides: []string{"service"},
+			boot: func(ctx context.Context, app di.Application) error {
+				_, hasDeadline = ctx.Deadline()
+				return nil
+			},
+		})
+
+		err := app.ModuleLoader().BootstrapApplicationContext(ctx)
+		assert.NoError(t, err)
+		assert.True(t, hasDeadline)
+	})
+}
+
+// TestApplication_ParallelBoot tests opt-in parallel boot mode
+func TestApplication_ParallelBoot(t *testing.T) {
+	t.Run("boots_independent_providers_concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{
+			"app.boot.parallel": true,
+			"app.boot.workers":  2,
+		})
+
+		var barrier sync.WaitGroup
+		barrier.Add(2)
+		waitForPeer := func(ctx context.Context, app di.Application) error {
+			barrier.Done()
+			done := make(chan struct{})
+			go func() {
+				barrier.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+				return nil
+			case <-time.After(time.Second):
+				return errors.New("peer provider was not booted concurrently")
+			}
+		}
+
+		app.Register(&contextProvider{provides: []string{"service.a"}, boot: waitForPeer})
+		app.Register(&contextProvider{provides: []string{"service.b"}, boot: waitForPeer})
+
+		require.NoError(t, app.RegisterWithDependencies())
+		assert.NoError(t, app.BootServiceProviders())
+	})
+
+	t.Run("boots_dependencies_before_dependents", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{
+			"app.boot.parallel": true,
+		})
+
+		var mu sync.Mutex
+		var bootOrder []string
+		record := func(name string) func(ctx context.Context, app di.Application) error {
+			return func(ctx context.Context, app di.Application) error {
+				mu.Lock()
+				defer mu.Unlock()
+				bootOrder = append(bootOrder, name)
+				return nil
+			}
+		}
+
+		app.Register(&contextProvider{provides: []string{"service.c"}, requires: []string{"service.a", "service.b"}, boot: record("C")})
+		app.Register(&contextProvider{provides: []string{"service.b"}, requires: []string{"service.a"}, boot: record("B")})
+		app.Register(&contextProvider{provides: []string{"service.a"}, boot: record("A")})
+
+		require.NoError(t, app.RegisterWithDependencies())
+		require.NoError(t, app.BootServiceProviders())
+
+		assert.Equal(t, []string{"A", "B", "C"}, bootOrder)
+	})
+
+	t.Run("limits_concurrency_to_worker_count", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{
+			"app.boot.parallel": true,
+			"app.boot.workers":  1,
+		})
+
+		var running, maxRunning int32
+		boot := func(ctx context.Context, app di.Application) error {
+			current := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				observed := atomic.LoadInt32(&maxRunning)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxRunning, observed, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return nil
+		}
+
+		for _, service := range []string{"service.a", "service.b", "service.c"} {
+			app.Register(&contextProvider{provides: []string{service}, boot: boot})
+		}
+
+		require.NoError(t, app.RegisterWithDependencies())
+		require.NoError(t, app.BootServiceProviders())
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&maxRunning))
+	})
+
+	t.Run("stops_before_next_level_on_error", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{
+			"app.boot.parallel": true,
+		})
+
+		bootErr := errors.New("boot failed")
+		var siblingBooted int32
+
+		app.Register(&contextProvider{provides: []string{"service.a"}, boot: func(ctx context.Context, app di.Application) error {
+			return bootErr
+		}})
+		app.Register(&contextProvider{provides: []string{"service.b"}, boot: func(ctx context.Context, app di.Application) error {
+			atomic.StoreInt32(&siblingBooted, 1)
+			return nil
+		}})
+
+		dependent := diMocks.NewMockServiceProvider(t)
+		dependent.EXPECT().Providers().Return([]string{"service.c"}).Maybe()
+		dependent.EXPECT().Requires().Return([]string{"service.a"}).Maybe()
+		dependent.EXPECT().Register(app).Once()
+		app.Register(dependent)
+
+		require.NoError(t, app.RegisterWithDependencies())
+
+		err := app.BootServiceProviders()
+		assert.ErrorIs(t, err, bootErr)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&siblingBooted))
+		dependent.AssertNotCalled(t, "Boot", app)
+	})
+
+	for _, parallel := range []bool{true, false} {
+		name := "recovers_panicking_provider_sequential"
+		if parallel {
+			name = "recovers_panicking_provider_parallel"
+		}
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			app := newConfiguredApp(t, map[string]interface{}{
+				"app.boot.parallel": parallel,
+			})
+
+			provider := diMocks.NewMockServiceProvider(t)
+			provider.EXPECT().Providers().Return([]string{"service"}).Maybe()
+			provider.EXPECT().Requires().Return([]string{}).Maybe()
+			provider.EXPECT().Register(app).Once()
+			provider.EXPECT().Boot(app).Once().Run(func(args mock.Arguments) {
+				panic("boot exploded")
+			})
+			app.Register(provider)
+
+			require.NoError(t, app.RegisterWithDependencies())
+
+			err := app.BootServiceProviders()
+			var providerErr *core.ProviderBootError
+			require.True(t, errors.As(err, &providerErr))
+			assert.Contains(t, err.Error(), "panic: boot exploded")
+			require.Len(t, app.BootReport().Providers, 1)
+			assert.False(t, app.BootReport().Providers[0].Booted)
+		})
+	}
+
+	t.Run("sequential_mode_is_default", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{})
+
+		var running, maxRunning int32
+		boot := func(ctx context.Context, app di.Application) error {
+			current := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			if current > atomic.LoadInt32(&maxRunning) {
+				atomic.StoreInt32(&maxRunning, current)
+			}
+			return nil
+		}
+
+		app.Register(&contextProvider{provides: []string{"service.a"}, boot: boot})
+		app.Register(&contextProvider{provides: []string{"service.b"}, boot: boot})
+
+		require.NoError(t, app.RegisterWithDependencies())
+		require.NoError(t, app.BootServiceProviders())
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&maxRunning))
+	})
+}
@@ -0,0 +1,228 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.fork.vn/di"
+)
+
+// maxPendingEvents là số lifecycle events tối đa được giữ lại khi log manager chưa sẵn sàng.
+const maxPendingEvents = 1024
+
+// lifecycleEvent là một bước lifecycle được log qua Log().
+//
+// Fields:
+//   - level: Mức log ("debug", "info", "warning", "error")
+//   - name: Tên event, ví dụ "provider.boot.end"
+//   - fields: Các cặp key, value mô tả event
+type lifecycleEvent struct {
+	level  string
+	name   string
+	fields []interface{}
+}
+
+// String format event thành message có cấu trúc.
+//
+// Message có dạng "lifecycle event=<name> key=value ...", giá trị chứa
+// khoảng trắng hoặc dấu "=" được quote để message parse được bằng máy.
+//
+// Trả về:
+//   - string: Message của event
+func (e lifecycleEvent) String() string {
+	var b strings.Builder
+	b.WriteString("lifecycle event=")
+	b.WriteString(e.name)
+	for i := 0; i+1 < len(e.fields); i += 2 {
+		b.WriteString(" ")
+		b.WriteString(fmt.Sprint(e.fields[i]))
+		b.WriteString("=")
+		b.WriteString(formatEventValue(e.fields[i+1]))
+	}
+	return b.String()
+}
+
+// formatEventValue format giá trị của một field.
+//
+// Tham số:
+//   - value: interface{} - Giá trị cần format
+//
+// Trả về:
+//   - string: Giá trị đã format, quote nếu cần
+func formatEventValue(value interface{}) string {
+	var s string
+	switch v := value.(type) {
+	case time.Duration:
+		s = v.String()
+	case error:
+		s = v.Error()
+	default:
+		s = fmt.Sprint(v)
+	}
+	if s == "" || strings.ContainsAny(s, " =\"\n\t") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+// lifecycleLog giữ lifecycle events cho tới khi log manager sẵn sàng.
+//
+// An toàn khi dùng đồng thời từ nhiều goroutines (parallel boot mode).
+type lifecycleLog struct {
+	mu      sync.Mutex
+	ready   bool
+	pending []lifecycleEvent
+	dropped int
+}
+
+// logEvent log một lifecycle event qua Log().
+//
+// Events phát sinh trước khi log manager sẵn sàng (provider cung cấp "log"
+// chưa boot) được giữ lại và log theo đúng thứ tự ngay khi log manager sẵn
+// sàng. Tối đa maxPendingEvents events được giữ, các events sau đó bị bỏ và
+// số lượng được báo bằng event "lifecycle.dropped".
+//
+// Tham số:
+//   - level: string - Mức log ("debug", "info", "warning", "error")
+//   - name: string - Tên event
+//   - fields: ...interface{} - Các cặp key, value mô tả event
+func (a *application) logEvent(level, name string, fields ...interface{}) {
+	event := lifecycleEvent{level: level, name: name, fields: fields}
+
+	l := a.events
+	l.mu.Lock()
+	if !l.ready && a.logReady() {
+		l.ready = true
+	}
+	if !l.ready {
+		if len(l.pending) < maxPendingEvents {
+			l.pending = append(l.pending, event)
+		} else {
+			l.dropped++
+		}
+		l.mu.Unlock()
+		return
+	}
+	l.mu.Unlock()
+
+	a.flushEvents(true)
+	a.writeEvent(event)
+}
+
+// flushEvents log các events đang được giữ lại.
+//
+// Tham số:
+//   - force: bool - Log cả khi provider cung cấp "log" chưa boot (ví dụ khi
+//     bootstrap thất bại), miễn là log manager resolve được
+func (a *application) flushEvents(force bool) {
+	l := a.events
+	l.mu.Lock()
+	if !l.ready && !force && !a.logReady() {
+		l.mu.Unlock()
+		return
+	}
+	if len(l.pending) == 0 && l.dropped == 0 {
+		l.mu.Unlock()
+		return
+	}
+	if _, ok := a.logManager(); !ok {
+		l.mu.Unlock()
+		return
+	}
+	pending, dropped := l.pending, l.dropped
+	l.pending, l.dropped = nil, 0
+	l.mu.Unlock()
+
+	for _, event := range pending {
+		a.writeEvent(event)
+	}
+	if dropped > 0 {
+		a.writeEvent(lifecycleEvent{level: "warning", name: "lifecycle.dropped", fields: []interface{}{"count", dropped}})
+	}
+}
+
+// writeEvent ghi event ra log manager ở level tương ứng.
+//
+// Tham số:
+//   - event: lifecycleEvent - Event cần ghi
+func (a *application) writeEvent(event lifecycleEvent) {
+	logger, ok := a.logManager()
+	if !ok {
+		return
+	}
+	switch event.level {
+	case "debug":
+		logger.Debug("%s", event.String())
+	case "warning":
+		logger.Warning("%s", event.String())
+	case "error":
+		logger.Error("%s", event.String())
+	default:
+		logger.Info("%s", event.String())
+	}
+}
+
+// logReady kiểm tra log manager đã sẵn sàng nhận events.
+//
+// Log manager sẵn sàng khi "log" đã được bind và mọi provider khai báo
+// "log" trong Providers() đã boot, để events không buộc log manager được
+// resolve trước khi provider cấu hình xong handlers.
+//
+// Trả về:
+//   - bool: true nếu có thể log qua Log()
+func (a *application) logReady() bool {
+	if !a.container.Bound("log") {
+		return false
+	}
+	for _, provider := range a.ServiceProviders() {
+		for _, service := range provider.Providers() {
+			if service == "log" && !a.recorder.isBooted(provider) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// registerProvider register provider, ghi nhận timing, metrics, span và log lifecycle events.
+//
+// Bindings được tạo trong Register được ghi nhận là của provider, để
+// UnloadProvider gỡ chúng hoặc khôi phục các bindings mà chúng che khuất.
+// Bindings của stagedApplication được ghi nhận khi commit.
+//
+// Tham số:
+//   - ctx: context.Context - Context chứa span cha của span register
+//   - app: di.Application - Application truyền vào Register
+//   - provider: di.ServiceProvider - Provider cần register
+func (a *application) registerProvider(ctx context.Context, app di.Application, provider di.ServiceProvider) {
+	name := providerName(provider)
+	a.logEvent("debug", "provider.register.start", "provider", name)
+	_, span := a.startSpan(ctx, SpanProviderRegister, "provider", name)
+	if staged, ok := app.(*stagedApplication); !ok || staged.application != a {
+		revision := a.container.trackBindings()
+		defer func() { a.ownBindings(provider, a.container.claimBindings(revision)) }()
+	}
+	elapsed := a.recorder.registerProvider(app, provider, a.trackAllocations())
+	span.End(nil)
+	a.recordRegister(provider, elapsed)
+	a.logEvent("info", "provider.register.end", "provider", name, "duration", elapsed)
+}
+
+// logSkippedProviders log các providers không được boot do boot bị dừng giữa chừng.
+//
+// Tham số:
+//   - providers: []di.ServiceProvider - Providers chưa được boot khi boot dừng lại
+//   - err: error - Lỗi làm dừng quá trình boot
+func (a *application) logSkippedProviders(providers []di.ServiceProvider, err error) {
+	for _, provider := range providers {
+		if a.recorder.isBooted(provider) {
+			continue
+		}
+		a.logEvent("warning", "provider.boot.skipped", "provider", providerName(provider),
+			"reason", "boot aborted", "error", err)
+	}
+}
@@ -0,0 +1,105 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+	"go.fork.vn/core/coretest"
+	"go.fork.vn/di"
+)
+
+// TestApplication_LifecycleEvents tests structured logging of register and boot steps
+func TestApplication_LifecycleEvents(t *testing.T) {
+	// failingProvider tạo provider cung cấp service và boot thất bại.
+	failingProvider := func(provides string) *contextProvider {
+		return &contextProvider{
+			provides: []string{provides},
+			boot: func(ctx context.Context, app di.Application) error {
+				return errors.New("connection refused")
+			},
+		}
+	}
+
+	t.Run("buffers_events_until_log_provider_boots", func(t *testing.T) {
+		t.Parallel()
+
+		logs := &coretest.LogRecorder{}
+		app := core.New(map[string]interface{}{})
+		app.Register(newBindingProvider("database", "primary"))
+		app.Register(newBindingProvider("log", logs))
+		app.Register(newBindingProvider("repository", "repository", "database"))
+		require.NoError(t, app.Boot())
+
+		entries := logs.Entries()
+		require.NotEmpty(t, entries)
+		assert.Equal(t, coretest.LogEntry{
+			Level:   "debug",
+			Message: "lifecycle event=provider.register.start provider=*core_test.bindingProvider",
+		}, entries[0])
+		assert.True(t, strings.HasPrefix(entries[len(entries)-1].Message, "lifecycle event=boot.end duration="))
+
+		assert.Equal(t, 3, countEvents(entries, "info", "event=provider.register.end provider=*core_test.bindingProvider duration="))
+		assert.Equal(t, 3, countEvents(entries, "info", "event=provider.boot.end provider=*core_test.bindingProvider duration="))
+		assert.True(t, logs.Contains("info", "lifecycle event=boot.start mode=sequential providers=3"))
+	})
+
+	t.Run("logs_failed_and_skipped_providers", func(t *testing.T) {
+		t.Parallel()
+
+		logs := &coretest.LogRecorder{}
+		app := core.New(map[string]interface{}{})
+		app.Register(newBindingProvider("log", logs))
+		app.Register(failingProvider("database"))
+		app.Register(newBindingProvider("repository", "repository", "database"))
+		require.Error(t, app.Boot())
+
+		assert.True(t, logs.Contains("error", `event=provider.boot.failed provider=*core_test.contextProvider duration=`))
+		assert.True(t, logs.Contains("error", `error="failed to boot service provider *core_test.contextProvider: connection refused"`))
+		assert.True(t, logs.Contains("warning", `event=provider.boot.skipped provider=*core_test.bindingProvider reason="boot aborted"`))
+		assert.True(t, logs.Contains("error", "event=boot.failed duration="))
+	})
+
+	t.Run("flushes_buffered_events_when_boot_fails_before_log_boots", func(t *testing.T) {
+		t.Parallel()
+
+		logs := &coretest.LogRecorder{}
+		app := core.New(map[string]interface{}{})
+		app.Register(failingProvider("database"))
+		app.Register(newBindingProvider("log", logs, "database"))
+		require.Error(t, app.Boot())
+
+		assert.True(t, logs.Contains("debug", "event=provider.register.start provider=*core_test.contextProvider"))
+		assert.True(t, logs.Contains("error", "event=provider.boot.failed provider=*core_test.contextProvider"))
+		assert.True(t, logs.Contains("warning", "event=provider.boot.skipped provider=*core_test.bindingProvider"))
+	})
+
+	t.Run("logs_already_booted_providers_as_skipped", func(t *testing.T) {
+		t.Parallel()
+
+		logs := &coretest.LogRecorder{}
+		app := core.New(map[string]interface{}{})
+		app.Register(newBindingProvider("log", logs))
+		app.Register(newBindingProvider("database", "primary"))
+		require.NoError(t, app.Boot())
+		logs.Reset()
+
+		require.NoError(t, app.BootServicesContext(context.Background(), "database"))
+		assert.True(t, logs.Contains("debug", `event=provider.boot.skipped provider=*core_test.bindingProvider reason="already booted"`))
+	})
+}
+
+// countEvents đếm số entries ở level chứa substring.
+func countEvents(entries []coretest.LogEntry, level, substring string) int {
+	count := 0
+	for _, entry := range entries {
+		if entry.Level == level && strings.Contains(entry.Message, substring) {
+			count++
+		}
+	}
+	return count
+}
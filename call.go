@@ -0,0 +1,297 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+)
+
+// Inject là hint cho CallSafe: parameter tại Index được resolve bằng abstract
+// thay vì theo type.
+type Inject struct {
+	// Index là vị trí parameter của callback, bắt đầu từ 0
+	Index int
+	// Abstract là tên service cần resolve cho parameter
+	Abstract string
+}
+
+// Named tạo hint inject service abstract vào parameter tại index.
+//
+// Tham số:
+//   - index: int - Vị trí parameter của callback, bắt đầu từ 0
+//   - abstract: string - Tên service cần resolve
+//
+// Trả về:
+//   - Inject: Hint truyền vào CallSafe cùng các params
+//
+// Ví dụ:
+//
+//	app.CallSafe(func(primary, replica *sql.DB) error { ... },
+//	    core.Named(0, "db.primary"), core.Named(1, "db.replica"))
+func Named(index int, abstract string) Inject {
+	return Inject{Index: index, Abstract: abstract}
+}
+
+// InjectionError represent lỗi khi không inject được một parameter của callback.
+type InjectionError struct {
+	// Callback là type của callback, ví dụ "func(*sql.DB) error"
+	Callback string
+	// Index là vị trí parameter không inject được, -1 nếu không xác định được
+	Index int
+	// Type là type của parameter, rỗng nếu Index là -1
+	Type string
+	// Abstract là service được chỉ định qua Named, rỗng nếu resolve theo type
+	Abstract string
+	// Err là lỗi gốc
+	Err error
+}
+
+// Error implement error interface.
+//
+// Trả về:
+//   - string: Error message với vị trí, type và service của parameter
+func (e *InjectionError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("cannot inject parameters of %s: %v", e.Callback, e.Err)
+	}
+	if e.Abstract != "" {
+		return fmt.Sprintf("cannot inject parameter %d (%s) of %s from service '%s': %v", e.Index, e.Type, e.Callback, e.Abstract, e.Err)
+	}
+	return fmt.Sprintf("cannot inject parameter %d (%s) of %s: %v", e.Index, e.Type, e.Callback, e.Err)
+}
+
+// Unwrap trả về lỗi gốc để hỗ trợ errors.Is và errors.As.
+func (e *InjectionError) Unwrap() error {
+	return e.Err
+}
+
+// CallPanicError represent panic xảy ra trong callback được gọi qua CallSafe.
+type CallPanicError struct {
+	// Callback là type của callback
+	Callback string
+	// Value là giá trị truyền vào panic
+	Value interface{}
+	// Stack là stack trace tại thời điểm panic
+	Stack []byte
+}
+
+// Error implement error interface.
+//
+// Trả về:
+//   - string: Error message với type của callback và giá trị panic
+func (e *CallPanicError) Error() string {
+	return fmt.Sprintf("callback %s panicked: %v", e.Callback, e.Value)
+}
+
+// Unwrap trả về giá trị panic nếu đó là error.
+func (e *CallPanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// CallSafe gọi function với auto dependency injection, không bao giờ panic.
+//
+// Implement Application interface method.
+//
+// Khác với Call:
+//   - Panic trong callback được trả về dưới dạng *CallPanicError
+//   - Mỗi parameter được resolve đúng một lần trước khi gọi callback; parameter
+//     đầu tiên không inject được (kể cả khi binding panic) được trả về dưới
+//     dạng *InjectionError với vị trí và type của parameter
+//   - Hint Named(index, abstract) trong params resolve parameter tại index
+//     bằng Make(abstract) thay vì theo type; các params khác được gán cho
+//     parameters như Call, parameters còn lại được resolve bằng Make theo tên type
+//
+// Tham số:
+//   - callback: interface{} - Function để gọi
+//   - params: ...interface{} - Additional parameters và Inject hints
+//
+// Trả về:
+//   - []interface{}: Function return values
+//   - error: *InjectionError, *CallPanicError hoặc lỗi nếu callback không phải function
+func (a *application) CallSafe(callback interface{}, params ...interface{}) (results []interface{}, err error) {
+	a.auditCall(callback)
+
+	fn := reflect.ValueOf(callback)
+	if fn.Kind() != reflect.Func || fn.IsNil() {
+		return nil, fmt.Errorf("callback must be a function, got %T", callback)
+	}
+	fnType := fn.Type()
+	name := fnType.String()
+
+	// Tách hints khỏi additional params
+	hints := make(map[int]string)
+	additional := make([]interface{}, 0, len(params))
+	for _, param := range params {
+		hint, ok := param.(Inject)
+		if !ok {
+			additional = append(additional, param)
+			continue
+		}
+		if hint.Index < 0 || hint.Index >= fnType.NumIn() {
+			return nil, &InjectionError{Callback: name, Index: hint.Index, Abstract: hint.Abstract,
+				Err: fmt.Errorf("callback has %d parameters", fnType.NumIn())}
+		}
+		hints[hint.Index] = hint.Abstract
+	}
+
+	// Resolve mỗi parameter một lần trước khi gọi callback, theo thứ tự parameters
+	assigned, _ := assignParameters(fnType, additional, hints)
+	args := make([]reflect.Value, fnType.NumIn())
+	for index := range args {
+		paramType := fnType.In(index)
+		if param, ok := assigned[index]; ok {
+			args[index] = reflect.ValueOf(param)
+			continue
+		}
+
+		abstract, named := hints[index]
+		if !named {
+			abstract = paramType.String()
+			if fnType.IsVariadic() && index == fnType.NumIn()-1 && !a.container.Bound(abstract) {
+				// Variadic parameter không có binding nhận slice rỗng
+				args[index] = reflect.MakeSlice(paramType, 0, 0)
+				continue
+			}
+		}
+		value, err := a.resolveNamed(paramType, abstract)
+		if err != nil {
+			injectErr := &InjectionError{Callback: name, Index: index, Type: paramType.String(), Err: err}
+			if named {
+				injectErr.Abstract = abstract
+			}
+			return nil, injectErr
+		}
+		args[index] = value
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			results = nil
+			err = &CallPanicError{Callback: name, Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	var out []reflect.Value
+	if fnType.IsVariadic() {
+		out = fn.CallSlice(args)
+	} else {
+		out = fn.Call(args)
+	}
+	results = make([]interface{}, len(out))
+	for i, value := range out {
+		results[i] = value.Interface()
+	}
+	return results, nil
+}
+
+// CallSafeContext gọi CallSafe với context.
+//
+// Implement Application interface method.
+//
+// Tham số:
+//   - ctx: context.Context - Context điều khiển lời gọi
+//   - callback: interface{} - Function để gọi
+//   - params: ...interface{} - Additional parameters và Inject hints
+//
+// Trả về:
+//   - []interface{}: Function return values
+//   - error: Lỗi từ CallSafe hoặc ctx.Err() nếu context bị hủy
+func (a *application) CallSafeContext(ctx context.Context, callback interface{}, params ...interface{}) ([]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var results []interface{}
+	err := runWithContext(ctx, func() error {
+		values, err := a.CallSafe(callback, params...)
+		results = values
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// resolveNamed resolve service cho parameter có hint Named.
+//
+// Tham số:
+//   - paramType: reflect.Type - Type của parameter
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - reflect.Value: Giá trị gán được cho parameter
+//   - error: Lỗi nếu resolve thất bại, panic hoặc instance không đúng type
+func (a *application) resolveNamed(paramType reflect.Type, abstract string) (value reflect.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	instance, err := a.Make(abstract)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if instance == nil {
+		switch paramType.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+			return reflect.Zero(paramType), nil
+		}
+		return reflect.Value{}, errors.New("resolved nil is not assignable")
+	}
+	resolved := reflect.ValueOf(instance)
+	if !resolved.Type().AssignableTo(paramType) {
+		return reflect.Value{}, fmt.Errorf("resolved %T is not assignable", instance)
+	}
+	return resolved, nil
+}
+
+// assignParameters gán additional params cho parameters của callback.
+//
+// Giống container.Call, mỗi additional param được gán cho parameter kế tiếp
+// nhận được nó; các parameters còn lại được resolve theo tên type.
+//
+// Tham số:
+//   - fnType: reflect.Type - Type của callback
+//   - additional: []interface{} - Additional params
+//   - skip: map[int]string - Vị trí các parameters đã được resolve qua hint Named
+//
+// Trả về:
+//   - map[int]interface{}: Additional param được gán theo vị trí parameter
+//   - []int: Vị trí các parameters cần resolve theo type
+func assignParameters(fnType reflect.Type, additional []interface{}, skip map[int]string) (map[int]interface{}, []int) {
+	assigned := make(map[int]interface{})
+	injected := make([]int, 0, fnType.NumIn())
+	next := 0
+	for i := 0; i < fnType.NumIn(); i++ {
+		if _, ok := skip[i]; ok {
+			continue
+		}
+		if next < len(additional) && additional[next] != nil && reflect.TypeOf(additional[next]).AssignableTo(fnType.In(i)) {
+			assigned[i] = additional[next]
+			next++
+			continue
+		}
+		injected = append(injected, i)
+	}
+	return assigned, injected
+}
+
+// auditInjections ghi nhận các services được inject vào callback nếu audit mode được bật.
+//
+// Tham số:
+//   - fnType: reflect.Type - Type của callback đã gọi thành công
+//   - additional: []interface{} - Additional params truyền cho container
+func (a *application) auditInjections(fnType reflect.Type, additional []interface{}) {
+	if a.audit.Load() == nil || fnType == nil || fnType.Kind() != reflect.Func {
+		return
+	}
+	_, injected := assignParameters(fnType, additional, nil)
+	for _, index := range injected {
+		a.auditResolve(fnType.In(index).String())
+	}
+}
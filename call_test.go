@@ -0,0 +1,175 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+	"go.fork.vn/di"
+)
+
+// TestApplication_CallSafe tests panic-safe calls with typed injection errors
+func TestApplication_CallSafe(t *testing.T) {
+	t.Run("calls_function_with_parameters", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Bind("string", func(c di.Container) interface{} {
+			return "injected-value"
+		})
+
+		result, err := app.CallSafe(func(param string) string {
+			return "result: " + param
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"result: injected-value"}, result)
+	})
+
+	t.Run("recovers_callback_panic", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		result, err := app.CallSafe(func() {
+			panic(assert.AnError)
+		})
+		assert.Nil(t, result)
+
+		var panicErr *core.CallPanicError
+		require.True(t, errors.As(err, &panicErr))
+		assert.Equal(t, "func()", panicErr.Callback)
+		assert.NotEmpty(t, panicErr.Stack)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("reports_parameter_when_binding_panics", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Bind("string", func(c di.Container) interface{} {
+			panic("connection refused")
+		})
+
+		called := false
+		_, err := app.CallSafe(func(param string) {
+			called = true
+		})
+		assert.False(t, called)
+
+		var injectErr *core.InjectionError
+		require.True(t, errors.As(err, &injectErr))
+		assert.Equal(t, 0, injectErr.Index)
+		assert.Equal(t, "string", injectErr.Type)
+		assert.Empty(t, injectErr.Abstract)
+		assert.Contains(t, err.Error(), "cannot inject parameter 0 (string)")
+	})
+
+	t.Run("resolves_each_parameter_once", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		calls := 0
+		app.Bind("string", func(c di.Container) interface{} {
+			calls++
+			return "transient"
+		})
+
+		_, err := app.CallSafe(func(name string, retries int) {})
+
+		var injectErr *core.InjectionError
+		require.True(t, errors.As(err, &injectErr))
+		assert.Equal(t, 1, injectErr.Index)
+		assert.Equal(t, "int", injectErr.Type)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("reports_parameter_after_additional_params", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		_, err := app.CallSafe(func(name string, retries int) {}, "job")
+
+		var injectErr *core.InjectionError
+		require.True(t, errors.As(err, &injectErr))
+		assert.Equal(t, 1, injectErr.Index)
+		assert.Equal(t, "int", injectErr.Type)
+	})
+
+	t.Run("injects_named_parameters", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Instance("db.primary", "primary")
+		app.Instance("db.replica", "replica")
+
+		result, err := app.CallSafe(func(primary, replica string) string {
+			return primary + "/" + replica
+		}, core.Named(0, "db.primary"), core.Named(1, "db.replica"))
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"primary/replica"}, result)
+	})
+
+	t.Run("reports_missing_named_service", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		_, err := app.CallSafe(func(replica string) {}, core.Named(0, "db.replica"))
+
+		var injectErr *core.InjectionError
+		require.True(t, errors.As(err, &injectErr))
+		assert.Equal(t, 0, injectErr.Index)
+		assert.Equal(t, "string", injectErr.Type)
+		assert.Equal(t, "db.replica", injectErr.Abstract)
+	})
+
+	t.Run("reports_named_service_of_wrong_type", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Instance("db.port", 5432)
+
+		_, err := app.CallSafe(func(port string) {}, core.Named(0, "db.port"))
+
+		var injectErr *core.InjectionError
+		require.True(t, errors.As(err, &injectErr))
+		assert.Contains(t, err.Error(), "resolved int is not assignable")
+	})
+
+	t.Run("rejects_hint_out_of_range", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Instance("db.replica", "replica")
+
+		_, err := app.CallSafe(func(replica string) {}, core.Named(1, "db.replica"))
+
+		var injectErr *core.InjectionError
+		require.True(t, errors.As(err, &injectErr))
+		assert.Equal(t, 1, injectErr.Index)
+	})
+
+	t.Run("rejects_non_function_callback", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		_, err := app.CallSafe("not a function")
+		assert.Error(t, err)
+	})
+
+	t.Run("returns_context_error_when_cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := app.CallSafeContext(ctx, func() {})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
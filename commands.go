@@ -0,0 +1,399 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// DiagnosticCommands trả về các diagnostic commands chuẩn của core.
+//
+// Commands bao gồm:
+//   - providers:list: Providers theo thứ tự đăng ký và thứ tự boot, services cung cấp/yêu cầu
+//   - graph: Dependency graph dạng DOT hoặc Mermaid
+//   - config:show: Config hiện tại với các giá trị nhạy cảm đã được ẩn
+//   - doctor: Kiểm tra config, missing dependencies và cycles mà không boot
+//
+// Các commands này được NewKernel thêm sẵn. Chúng chỉ đăng ký core providers
+// (config, log) như BootstrapApplication() để output phản ánh đúng application
+// khi chạy, và không Register hay Boot các providers khác.
+//
+// Trả về:
+//   - []Command: Diagnostic commands
+func DiagnosticCommands() []Command {
+	var graphFormat string
+
+	return []Command{
+		{
+			Name:          "providers:list",
+			Description:   "Liệt kê service providers theo thứ tự đăng ký và thứ tự boot",
+			SkipBootstrap: true,
+			Run:           runProvidersList,
+		},
+		{
+			Name:          "graph",
+			Description:   "Xuất dependency graph của service providers (DOT hoặc Mermaid)",
+			SkipBootstrap: true,
+			Flags: func(flags *flag.FlagSet) {
+				flags.StringVar(&graphFormat, "format", "dot", "Định dạng output: dot hoặc mermaid")
+			},
+			Run: func(ctx context.Context, app Application, args []string) error {
+				return runGraph(ctx, app, graphFormat)
+			},
+		},
+		{
+			Name:          "config:show",
+			Description:   "Hiển thị config hiện tại, các giá trị nhạy cảm đã được ẩn",
+			Usage:         "[key]",
+			SkipBootstrap: true,
+			Run:           runConfigShow,
+		},
+		{
+			Name:          "doctor",
+			Description:   "Kiểm tra config và dependencies của providers mà không boot",
+			SkipBootstrap: true,
+			Run:           runDoctor,
+		},
+	}
+}
+
+// runProvidersList in bảng providers với thứ tự đăng ký, thứ tự boot, services cung cấp và yêu cầu.
+//
+// Tham số:
+//   - ctx: context.Context - Context của command
+//   - app: Application - Application instance
+//   - args: []string - Không sử dụng
+//
+// Trả về:
+//   - error: Lỗi nếu chuẩn bị providers thất bại
+func runProvidersList(ctx context.Context, app Application, args []string) error {
+	if err := registerDiagnosticProviders(ctx, app); err != nil {
+		return err
+	}
+
+	graph := newProviderGraph(app.ServiceProviders())
+	levels, _ := graph.levels()
+
+	bootOrder := make(map[string]int)
+	levelOf := make(map[string]int)
+	for level, keys := range levels {
+		for _, key := range keys {
+			bootOrder[key] = len(bootOrder) + 1
+			levelOf[key] = level
+		}
+	}
+
+	w := tabwriter.NewWriter(CommandOutput(ctx), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "#\tBOOT\tLEVEL\tPROVIDER\tPROVIDES\tREQUIRES")
+	for index, key := range graph.keys {
+		boot, level := "-", "-"
+		if position, ok := bootOrder[key]; ok {
+			boot = strconv.Itoa(position)
+			level = strconv.Itoa(levelOf[key])
+		}
+		provider := graph.providers[key]
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			index+1, boot, level, graph.name(key),
+			joinOrDash(provider.Providers()), joinOrDash(provider.Requires()))
+	}
+	return w.Flush()
+}
+
+// runGraph in dependency graph dạng DOT hoặc Mermaid.
+//
+// Mỗi cạnh đi từ provider tới provider mà nó phụ thuộc, được gán nhãn bằng
+// service được require. Services bị thiếu được vẽ thành node riêng.
+//
+// Tham số:
+//   - ctx: context.Context - Context của command
+//   - app: Application - Application instance
+//   - format: string - "dot" hoặc "mermaid"
+//
+// Trả về:
+//   - error: Lỗi nếu format không được hỗ trợ hoặc chuẩn bị providers thất bại
+func runGraph(ctx context.Context, app Application, format string) error {
+	if format != "dot" && format != "mermaid" {
+		return fmt.Errorf("unsupported graph format %q: expected dot or mermaid", format)
+	}
+	if err := registerDiagnosticProviders(ctx, app); err != nil {
+		return err
+	}
+
+	graph := newProviderGraph(app.ServiceProviders())
+	switch format {
+	case "dot":
+		writeDotGraph(CommandOutput(ctx), graph)
+	case "mermaid":
+		writeMermaidGraph(CommandOutput(ctx), graph)
+	}
+	return nil
+}
+
+// graphEdge là một cạnh dependency trong graph output.
+type graphEdge struct {
+	from    int
+	to      string
+	service string
+}
+
+// graphEdges liệt kê các cạnh dependency theo thứ tự đăng ký và thứ tự Requires().
+//
+// Tham số:
+//   - graph: *providerGraph - Dependency graph
+//   - ids: map[string]string - Map provider key tới node id
+//
+// Trả về:
+//   - []graphEdge: Các cạnh, node đích là node id của provider hoặc của service bị thiếu
+//   - []string: Services bị thiếu theo thứ tự xuất hiện, node id là "m<index>"
+func graphEdges(graph *providerGraph, ids map[string]string) ([]graphEdge, []string) {
+	edges := make([]graphEdge, 0)
+	missing := make([]string, 0)
+	missingIDs := make(map[string]string)
+
+	for index, key := range graph.keys {
+		for _, service := range graph.providers[key].Requires() {
+			if dependency, exists := graph.services[service]; exists {
+				edges = append(edges, graphEdge{from: index, to: ids[dependency], service: service})
+				continue
+			}
+			id, exists := missingIDs[service]
+			if !exists {
+				id = fmt.Sprintf("m%d", len(missing))
+				missingIDs[service] = id
+				missing = append(missing, service)
+			}
+			edges = append(edges, graphEdge{from: index, to: id, service: service})
+		}
+	}
+	return edges, missing
+}
+
+// graphNodeIDs gán node id "p<index>" cho mỗi provider theo thứ tự đăng ký.
+//
+// Tham số:
+//   - graph: *providerGraph - Dependency graph
+//
+// Trả về:
+//   - map[string]string: Map provider key tới node id
+func graphNodeIDs(graph *providerGraph) map[string]string {
+	ids := make(map[string]string, len(graph.keys))
+	for index, key := range graph.keys {
+		ids[key] = fmt.Sprintf("p%d", index)
+	}
+	return ids
+}
+
+// writeDotGraph ghi dependency graph dạng Graphviz DOT.
+//
+// Tham số:
+//   - out: io.Writer - Writer đích
+//   - graph: *providerGraph - Dependency graph
+func writeDotGraph(out io.Writer, graph *providerGraph) {
+	ids := graphNodeIDs(graph)
+	edges, missing := graphEdges(graph, ids)
+
+	fmt.Fprintln(out, "digraph providers {")
+	fmt.Fprintln(out, "  rankdir=LR;")
+	fmt.Fprintln(out, "  node [shape=box];")
+	for _, key := range graph.keys {
+		fmt.Fprintf(out, "  %s [label=%q];\n", ids[key], graph.name(key))
+	}
+	for index, service := range missing {
+		fmt.Fprintf(out, "  m%d [label=%q, style=dashed, color=red];\n", index, "missing: "+service)
+	}
+	for _, edge := range edges {
+		fmt.Fprintf(out, "  p%d -> %s [label=%q];\n", edge.from, edge.to, edge.service)
+	}
+	fmt.Fprintln(out, "}")
+}
+
+// writeMermaidGraph ghi dependency graph dạng Mermaid flowchart.
+//
+// Tham số:
+//   - out: io.Writer - Writer đích
+//   - graph: *providerGraph - Dependency graph
+func writeMermaidGraph(out io.Writer, graph *providerGraph) {
+	ids := graphNodeIDs(graph)
+	edges, missing := graphEdges(graph, ids)
+
+	fmt.Fprintln(out, "graph LR")
+	for _, key := range graph.keys {
+		fmt.Fprintf(out, "  %s[\"%s\"]\n", ids[key], mermaidEscape(graph.name(key)))
+	}
+	for index, service := range missing {
+		fmt.Fprintf(out, "  m%d[\"missing: %s\"]:::missing\n", index, mermaidEscape(service))
+	}
+	for _, edge := range edges {
+		fmt.Fprintf(out, "  p%d -->|%s| %s\n", edge.from, mermaidEscape(edge.service), edge.to)
+	}
+	if len(missing) > 0 {
+		fmt.Fprintln(out, "  classDef missing stroke:#f00,stroke-dasharray:5")
+	}
+}
+
+// mermaidEscape escape các ký tự đặc biệt trong label Mermaid.
+//
+// Tham số:
+//   - value: string - Label gốc
+//
+// Trả về:
+//   - string: Label đã escape
+func mermaidEscape(value string) string {
+	replacer := strings.NewReplacer(`"`, "#quot;", "|", "#124;")
+	return replacer.Replace(value)
+}
+
+// runConfigShow in config hiện tại dạng JSON với các giá trị nhạy cảm đã được ẩn.
+//
+// Tham số:
+//   - ctx: context.Context - Context của command
+//   - app: Application - Application instance
+//   - args: []string - Key tùy chọn để chỉ in một nhánh config
+//
+// Trả về:
+//   - error: Lỗi nếu không đọc được config hoặc key không tồn tại
+func runConfigShow(ctx context.Context, app Application, args []string) error {
+	if err := registerDiagnosticProviders(ctx, app); err != nil {
+		return err
+	}
+
+	cfg, ok := appConfig(app)
+	if !ok {
+		return fmt.Errorf("config manager not found")
+	}
+
+	var value interface{} = redactSettings(cfg.AllSettings())
+	if len(args) > 0 {
+		key := args[0]
+		found := false
+		value, found = lookupSetting(value, key)
+		if !found {
+			return fmt.Errorf("config key '%s' not found", key)
+		}
+	}
+
+	encoder := json.NewEncoder(CommandOutput(ctx))
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+// lookupSetting tìm giá trị theo key dạng "a.b.c" trong settings đã redact.
+//
+// Tham số:
+//   - settings: interface{} - Settings dạng nested maps
+//   - key: string - Key phân cách bằng dấu chấm
+//
+// Trả về:
+//   - interface{}: Giá trị tìm được
+//   - bool: true nếu key tồn tại
+func lookupSetting(settings interface{}, key string) (interface{}, bool) {
+	current := settings
+	for _, part := range strings.Split(key, ".") {
+		values, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		current, ok = values[part]
+		if !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// runDoctor kiểm tra config và dependency graph mà không boot providers.
+//
+// Các kiểm tra:
+//  1. Config đọc được, core providers và plugins đăng ký thành công, module
+//     manifests tương thích
+//  2. Dependency graph qua Application.Plan(): lỗi được đánh dấu [FAIL],
+//     cảnh báo được đánh dấu [WARN]
+//
+// Tham số:
+//   - ctx: context.Context - Context của command
+//   - app: Application - Application instance
+//   - args: []string - Không sử dụng
+//
+// Trả về:
+//   - error: Lỗi tổng hợp số vấn đề ở mức lỗi, nil nếu không có
+func runDoctor(ctx context.Context, app Application, args []string) error {
+	out := CommandOutput(ctx)
+	problems := 0
+
+	var compatibilityErr *ModuleCompatibilityError
+	if err := registerDiagnosticProviders(ctx, app); errors.As(err, &compatibilityErr) {
+		problems++
+		fmt.Fprintf(out, "[FAIL] modules: %v\n", err)
+	} else if err != nil {
+		problems++
+		fmt.Fprintf(out, "[FAIL] config: %v\n", err)
+	} else {
+		fmt.Fprintln(out, "[ OK ] config loaded")
+	}
+
+	plan := app.Plan()
+	missing, cycles := 0, 0
+	for _, problem := range plan.Errors() {
+		problems++
+		switch problem.Kind {
+		case ProblemMissingService:
+			missing++
+			fmt.Fprintf(out, "[FAIL] dependencies: %s\n", problem.Message)
+		case ProblemCircularDependency:
+			cycles++
+			fmt.Fprintf(out, "[FAIL] cycles: %s\n", strings.Join(problem.Providers, " -> "))
+		default:
+			fmt.Fprintf(out, "[FAIL] %s\n", problem.Message)
+		}
+	}
+	if missing == 0 {
+		fmt.Fprintf(out, "[ OK ] dependencies: %d provider(s), all required services provided\n", len(plan.Order))
+	}
+	if cycles == 0 {
+		fmt.Fprintln(out, "[ OK ] cycles: none detected")
+	}
+	for _, problem := range plan.Warnings() {
+		fmt.Fprintf(out, "[WARN] %s\n", problem.Message)
+	}
+
+	if problems > 0 {
+		return fmt.Errorf("doctor found %d problem(s)", problems)
+	}
+	return nil
+}
+
+// registerDiagnosticProviders chuẩn bị providers cho diagnostic commands.
+//
+// Mọi diagnostic command dùng chung bước này để core providers, plugins khai
+// báo trong config và services của chúng có mặt trong graph như khi
+// BootstrapApplication(), nhưng Register của providers không được gọi.
+//
+// Tham số:
+//   - ctx: context.Context - Context của command
+//   - app: Application - Application instance
+//
+// Trả về:
+//   - error: Lỗi nếu đọc config, load plugins hoặc kiểm tra module manifests thất bại
+func registerDiagnosticProviders(ctx context.Context, app Application) error {
+	return prepareApplication(ctx, app)
+}
+
+// joinOrDash nối danh sách bằng dấu phẩy, trả về "-" nếu rỗng.
+//
+// Tham số:
+//   - values: []string - Danh sách giá trị
+//
+// Trả về:
+//   - string: Chuỗi đã nối
+func joinOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,256 @@
+package core_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+	"go.fork.vn/di"
+)
+
+// TestDiagnosticCommands tests the built-in diagnostic commands
+func TestDiagnosticCommands(t *testing.T) {
+	t.Run("registered_by_default", func(t *testing.T) {
+		t.Parallel()
+
+		kernel, _, _ := newTestKernel(core.New(map[string]interface{}{}))
+
+		names := make([]string, 0)
+		for _, command := range kernel.Commands() {
+			names = append(names, command.Name)
+		}
+		assert.Equal(t, []string{"config:show", "doctor", "graph", "providers:list"}, names)
+	})
+
+	t.Run("providers_list_shows_registration_and_boot_order", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("repository", []string{"database"}))
+		app.Register(newCommandProvider("database", nil))
+
+		kernel, stdout, _ := newTestKernel(app)
+		require.NoError(t, kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "providers:list"}))
+
+		lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+		require.Len(t, lines, 4)
+		assert.Equal(t, []string{"#", "BOOT", "LEVEL", "PROVIDER", "PROVIDES", "REQUIRES"}, strings.Fields(lines[0]))
+		assert.Equal(t, []string{"1", "3", "1", "*core_test.commandProvider", "repository", "database"}, strings.Fields(lines[1]))
+		assert.Equal(t, []string{"2", "1", "0", "*core_test.commandProvider", "database", "-"}, strings.Fields(lines[2]))
+		// Log provider được đăng ký như khi bootstrap
+		assert.Equal(t, []string{"3", "2", "0"}, strings.Fields(lines[3])[:3])
+		assert.Contains(t, lines[3], "log")
+	})
+
+	t.Run("providers_requiring_log_are_not_missing_dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("mailer", []string{"log"}))
+
+		kernel, stdout, _ := newTestKernel(app)
+		require.NoError(t, kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "graph"}))
+		assert.NotContains(t, stdout.String(), "missing: log")
+	})
+
+	t.Run("providers_list_does_not_register_or_boot", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		provider := newCommandProvider("database", nil)
+		app.Register(provider)
+
+		kernel, _, _ := newTestKernel(app)
+		require.NoError(t, kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "providers:list"}))
+		assert.False(t, provider.booted)
+		assert.Empty(t, app.BootReport().Providers)
+	})
+
+	t.Run("graph_dot", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("database", nil))
+		app.Register(newCommandProvider("repository", []string{"database", "cache"}))
+
+		kernel, stdout, _ := newTestKernel(app)
+		require.NoError(t, kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "graph"}))
+
+		output := stdout.String()
+		assert.True(t, strings.HasPrefix(output, "digraph providers {"))
+		assert.Contains(t, output, `p0 [label="*core_test.commandProvider"];`)
+		assert.Contains(t, output, `p1 -> p0 [label="database"];`)
+		assert.Contains(t, output, `m0 [label="missing: cache", style=dashed, color=red];`)
+		assert.Contains(t, output, `p1 -> m0 [label="cache"];`)
+	})
+
+	t.Run("graph_mermaid", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("database", nil))
+		app.Register(newCommandProvider("repository", []string{"database"}))
+
+		kernel, stdout, _ := newTestKernel(app)
+		require.NoError(t, kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "graph", "-format", "mermaid"}))
+
+		output := stdout.String()
+		assert.True(t, strings.HasPrefix(output, "graph LR"))
+		assert.Contains(t, output, `p0["*core_test.commandProvider"]`)
+		assert.Contains(t, output, "p1 -->|database| p0")
+	})
+
+	t.Run("graph_rejects_unknown_format", func(t *testing.T) {
+		t.Parallel()
+
+		kernel, _, _ := newTestKernel(core.New(map[string]interface{}{}))
+		err := kernel.Run(context.Background(), []string{"graph", "-format", "svg"})
+		assert.ErrorContains(t, err, `unsupported graph format "svg"`)
+	})
+
+	t.Run("config_show_redacts_secrets", func(t *testing.T) {
+		t.Parallel()
+
+		kernel, stdout, _ := newTestKernel(core.New(map[string]interface{}{}))
+		require.NoError(t, kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "config:show"}))
+
+		var settings map[string]interface{}
+		require.NoError(t, json.Unmarshal(stdout.Bytes(), &settings))
+		assert.Contains(t, settings, "log")
+
+		stdout.Reset()
+		require.NoError(t, kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "config:show", "log.console"}))
+		assert.JSONEq(t, `{"enabled": true, "colored": true}`, stdout.String())
+	})
+
+	t.Run("config_show_redacts_camel_case_keys", func(t *testing.T) {
+		t.Parallel()
+
+		kernel, stdout, _ := newTestKernel(core.New(map[string]interface{}{}))
+		require.NoError(t, kernel.Run(context.Background(), []string{"--config", "testdata/configs/secrets.yaml", "config:show", "services.github"}))
+
+		assert.NotContains(t, stdout.String(), "gh-client-secret")
+		assert.NotContains(t, stdout.String(), "gh-access-token")
+		assert.NotContains(t, stdout.String(), "gh-private-key")
+		assert.JSONEq(t, `{
+			"clientid": "gh-client",
+			"clientsecret": "******",
+			"accesstoken": "******",
+			"privatekey": "******",
+			"secretlength": 32
+		}`, stdout.String())
+	})
+
+	t.Run("config_show_unknown_key", func(t *testing.T) {
+		t.Parallel()
+
+		kernel, _, _ := newTestKernel(core.New(map[string]interface{}{}))
+		err := kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "config:show", "missing.key"})
+		assert.ErrorContains(t, err, "config key 'missing.key' not found")
+	})
+
+	t.Run("doctor_passes_for_valid_app", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		provider := newCommandProvider("database", nil)
+		app.Register(provider)
+
+		kernel, stdout, _ := newTestKernel(app)
+		require.NoError(t, kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "doctor"}))
+
+		output := stdout.String()
+		assert.Contains(t, output, "[ OK ] config loaded")
+		assert.Contains(t, output, "all required services provided")
+		assert.Contains(t, output, "[ OK ] cycles: none detected")
+		assert.False(t, provider.booted)
+	})
+
+	t.Run("doctor_checks_module_manifests_without_registering", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newModuleProvider("billing", "2.0.1", map[string]string{"auth": "^1.0.0"}))
+		registered := false
+		app.Register(&registeringProvider{
+			lifecycleProvider: *newLifecycleProvider("cache"),
+			register:          func(app di.Application) { registered = true },
+		})
+
+		kernel, stdout, _ := newTestKernel(app)
+		err := kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "doctor"})
+		assert.EqualError(t, err, "doctor found 1 problem(s)")
+		assert.Contains(t, stdout.String(), "[FAIL] modules: incompatible module:")
+		assert.False(t, registered)
+	})
+
+	t.Run("doctor_reports_all_problems", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("a", []string{"b"}))
+		app.Register(newCommandProvider("b", []string{"a"}))
+		app.Register(newCommandProvider("c", []string{"cache", "queue"}))
+
+		kernel, stdout, _ := newTestKernel(app)
+		err := kernel.Run(context.Background(), []string{"--config", "missing.yaml", "doctor"})
+		assert.EqualError(t, err, "doctor found 4 problem(s)")
+
+		output := stdout.String()
+		assert.Contains(t, output, "[FAIL] config:")
+		assert.Contains(t, output, "requires 'cache'")
+		assert.Contains(t, output, "requires 'queue'")
+		assert.Contains(t, output, "[FAIL] cycles: *core_test.commandProvider -> *core_test.commandProvider -> *core_test.commandProvider")
+	})
+}
+
+// TestKernel_CommandPrecedence tests which command wins when names collide
+func TestKernel_CommandPrecedence(t *testing.T) {
+	t.Run("provider_overrides_diagnostic_command", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("database", nil, core.Command{
+			Name:          "doctor",
+			SkipBootstrap: true,
+			Run: func(ctx context.Context, app core.Application, args []string) error {
+				_, err := core.CommandOutput(ctx).Write([]byte("provider doctor\n"))
+				return err
+			},
+		}))
+
+		kernel, stdout, _ := newTestKernel(app)
+		require.NoError(t, kernel.Run(context.Background(), []string{"doctor"}))
+		assert.Equal(t, "provider doctor\n", stdout.String())
+	})
+
+	t.Run("added_command_overrides_provider", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("database", nil, core.Command{
+			Name:          "doctor",
+			SkipBootstrap: true,
+			Run: func(ctx context.Context, app core.Application, args []string) error {
+				return errors.New("provider doctor must not run")
+			},
+		}))
+
+		kernel, stdout, _ := newTestKernel(app)
+		kernel.Add(core.Command{
+			Name:          "doctor",
+			SkipBootstrap: true,
+			Run: func(ctx context.Context, app core.Application, args []string) error {
+				_, err := core.CommandOutput(ctx).Write([]byte("kernel doctor\n"))
+				return err
+			},
+		})
+		require.NoError(t, kernel.Run(context.Background(), []string{"doctor"}))
+		assert.Equal(t, "kernel doctor\n", stdout.String())
+	})
+}
@@ -0,0 +1,146 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"go.fork.vn/config"
+)
+
+// readInlineConfig nạp config inline từ app.config vào config manager thay cho file trên filesystem.
+//
+// Các nguồn được hỗ trợ:
+//   - "fs" (fs.FS) cùng "file": File config trong fs.FS, ví dụ embed.FS
+//   - "data" ([]byte hoặc string) cùng "type": Nội dung config YAML, JSON hoặc TOML
+//   - "settings" (map[string]interface{}): Config dạng nested maps
+//
+// Nội dung của "fs" và "data" được đọc bằng ReadConfig của config manager như một file config.
+// "settings" được ghi bằng Set sau đó và ghi đè các keys trùng.
+//
+// Tham số:
+//   - manager: config.Manager - Config manager nhận config
+//   - cfg: map[string]interface{} - Giá trị của app.config
+//
+// Trả về:
+//   - bool: true nếu app.config có nguồn inline
+//   - error: Lỗi nếu nguồn inline không hợp lệ hoặc không đọc được
+func readInlineConfig(manager config.Manager, cfg map[string]interface{}) (bool, error) {
+	data, fileType, inline, err := inlineData(cfg)
+	if err != nil {
+		return true, err
+	}
+
+	var settings map[string]interface{}
+	if source, ok := cfg["settings"]; ok {
+		settings, ok = source.(map[string]interface{})
+		if !ok {
+			return true, fmt.Errorf("invalid app.config settings type: expected map[string]interface{}, got %T", source)
+		}
+		inline = true
+	}
+
+	if data != nil {
+		manager.SetConfigType(fileType)
+		if err := manager.ReadConfig(bytes.NewReader(data)); err != nil {
+			return true, fmt.Errorf("config read failed: %w", err)
+		}
+	}
+	setSettings(manager, "", settings)
+
+	return inline, nil
+}
+
+// inlineData lấy nội dung config từ nguồn "fs" hoặc "data" của app.config.
+//
+// Tham số:
+//   - cfg: map[string]interface{} - Giá trị của app.config
+//
+// Trả về:
+//   - []byte: Nội dung config, nil nếu không có nguồn "fs" hoặc "data"
+//   - string: Type của nội dung, "yaml", "yml", "json" hoặc "toml"
+//   - bool: true nếu app.config có nguồn "fs" hoặc "data"
+//   - error: Lỗi nếu nguồn không hợp lệ, không đọc được hoặc type không được hỗ trợ
+func inlineData(cfg map[string]interface{}) ([]byte, string, bool, error) {
+	var data []byte
+	fileType, _ := cfg["type"].(string)
+
+	if source, ok := cfg["fs"]; ok {
+		fsys, ok := source.(fs.FS)
+		if !ok {
+			return nil, "", true, fmt.Errorf("invalid app.config fs type: expected fs.FS, got %T", source)
+		}
+		file, _ := cfg["file"].(string)
+		if file == "" {
+			return nil, "", true, fmt.Errorf("app.config fs requires a file")
+		}
+		content, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, "", true, fmt.Errorf("config read failed: %w", err)
+		}
+		if fileType == "" {
+			fileType = strings.TrimPrefix(path.Ext(file), ".")
+		}
+		data = content
+	} else if source, ok := cfg["data"]; ok {
+		switch value := source.(type) {
+		case []byte:
+			data = value
+		case string:
+			data = []byte(value)
+		default:
+			return nil, "", true, fmt.Errorf("invalid app.config data type: expected []byte or string, got %T", source)
+		}
+	} else {
+		return nil, "", false, nil
+	}
+
+	fileType = strings.ToLower(fileType)
+	switch fileType {
+	case "yaml", "yml", "json", "toml":
+		return data, fileType, true, nil
+	default:
+		return nil, "", true, fmt.Errorf("unsupported app.config type '%s': expected yaml, json or toml", fileType)
+	}
+}
+
+// inlineSource mô tả các nguồn config inline có trong app.config.
+//
+// Tham số:
+//   - cfg: map[string]interface{} - Giá trị của app.config
+//
+// Trả về:
+//   - string: Tên các nguồn nối bằng "+", ví dụ "fs+settings"
+func inlineSource(cfg map[string]interface{}) string {
+	sources := make([]string, 0, 2)
+	if _, ok := cfg["fs"]; ok {
+		sources = append(sources, "fs")
+	} else if _, ok := cfg["data"]; ok {
+		sources = append(sources, "data")
+	}
+	if _, ok := cfg["settings"]; ok {
+		sources = append(sources, "settings")
+	}
+	return strings.Join(sources, "+")
+}
+
+// setSettings ghi nested maps vào config manager theo keys dạng "a.b.c".
+//
+// Tham số:
+//   - manager: config.Manager - Config manager nhận settings
+//   - prefix: string - Key của map cha, rỗng ở cấp cao nhất
+//   - values: map[string]interface{} - Nested maps cần ghi
+func setSettings(manager config.Manager, prefix string, values map[string]interface{}) {
+	for key, value := range values {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+		if nested, ok := value.(map[string]interface{}); ok && len(nested) > 0 {
+			setSettings(manager, key, nested)
+			continue
+		}
+		manager.Set(key, value)
+	}
+}
@@ -0,0 +1,78 @@
+package core_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+)
+
+// TestModuleLoader_InlineConfig tests loading config without files on disk
+func TestModuleLoader_InlineConfig(t *testing.T) {
+	// configString đăng ký core providers và đọc string config.
+	configString := func(t *testing.T, cfg map[string]interface{}, key string) string {
+		app := core.New(cfg)
+		require.NoError(t, app.ModuleLoader().RegisterCoreProviders())
+		value, _ := app.Config().GetString(key)
+		return value
+	}
+
+	t.Run("loads_nested_settings", func(t *testing.T) {
+		t.Parallel()
+
+		value := configString(t, map[string]interface{}{
+			"settings": map[string]interface{}{
+				"app": map[string]interface{}{"name": "billing"},
+			},
+		}, "app.name")
+		assert.Equal(t, "billing", value)
+	})
+
+	t.Run("loads_raw_data", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "yaml", configString(t, map[string]interface{}{
+			"data": []byte("app:\n  name: yaml\n"),
+			"type": "yaml",
+		}, "app.name"))
+		assert.Equal(t, "json", configString(t, map[string]interface{}{
+			"data": `{"app": {"name": "json"}}`,
+			"type": "json",
+		}, "app.name"))
+		assert.Equal(t, "toml", configString(t, map[string]interface{}{
+			"data": []byte("[app]\nname = \"toml\"\n"),
+			"type": "toml",
+		}, "app.name"))
+	})
+
+	t.Run("loads_file_from_fs", func(t *testing.T) {
+		t.Parallel()
+
+		fsys := fstest.MapFS{
+			"configs/app.yaml": &fstest.MapFile{Data: []byte("app:\n  name: embedded\n  environment: staging\n")},
+		}
+		value := configString(t, map[string]interface{}{
+			"fs":   fsys,
+			"file": "configs/app.yaml",
+			"settings": map[string]interface{}{
+				"app": map[string]interface{}{"environment": "production"},
+			},
+		}, "app.environment")
+		assert.Equal(t, "production", value)
+	})
+
+	t.Run("rejects_invalid_sources", func(t *testing.T) {
+		t.Parallel()
+
+		err := core.New(map[string]interface{}{"data": "app: x", "type": "ini"}).ModuleLoader().RegisterCoreProviders()
+		assert.EqualError(t, err, "unsupported app.config type 'ini': expected yaml, json or toml")
+
+		err = core.New(map[string]interface{}{"fs": fstest.MapFS{}, "file": "missing.yaml"}).ModuleLoader().RegisterCoreProviders()
+		assert.ErrorContains(t, err, "config read failed")
+
+		err = core.New(map[string]interface{}{"settings": "app.name=x"}).ModuleLoader().RegisterCoreProviders()
+		assert.ErrorContains(t, err, "invalid app.config settings type")
+	})
+}
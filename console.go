@@ -0,0 +1,402 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// ErrCommandNotFound được trả về khi command không tồn tại trong kernel.
+var ErrCommandNotFound = errors.New("command not found")
+
+// commandOutputKey là context key chứa writer output của command.
+type commandOutputKey struct{}
+
+// CommandOutput trả về writer mà command nên ghi output vào.
+//
+// Tham số:
+//   - ctx: context.Context - Context được kernel truyền vào Command.Run
+//
+// Trả về:
+//   - io.Writer: Writer cấu hình qua Kernel.SetOutput, mặc định os.Stdout
+//
+// Ví dụ:
+//
+//	Run: func(ctx context.Context, app core.Application, args []string) error {
+//	    fmt.Fprintln(core.CommandOutput(ctx), "done")
+//	    return nil
+//	}
+func CommandOutput(ctx context.Context) io.Writer {
+	if out, ok := ctx.Value(commandOutputKey{}).(io.Writer); ok {
+		return out
+	}
+	return os.Stdout
+}
+
+// Command định nghĩa một console command.
+//
+// Fields:
+//   - Name: Tên command, dùng để gọi từ command line
+//   - Description: Mô tả ngắn, hiển thị trong danh sách commands
+//   - Usage: Mô tả positional arguments, ví dụ "<name> [version]"
+//   - Requires: Services cần sẵn sàng trước khi chạy; chỉ providers cung cấp
+//     các services này (và dependencies của chúng) được boot
+//   - SkipBootstrap: Không đăng ký và boot providers trước khi chạy; command tự
+//     xử lý (dùng cho diagnostic commands)
+//   - Flags: Hàm khai báo flags riêng của command, có thể nil
+//   - Run: Hàm thực thi command với positional arguments còn lại; output nên
+//     được ghi vào CommandOutput(ctx)
+type Command struct {
+	Name          string
+	Description   string
+	Usage         string
+	Requires      []string
+	SkipBootstrap bool
+	Flags         func(flags *flag.FlagSet)
+	Run           func(ctx context.Context, app Application, args []string) error
+}
+
+// CommandProvider là interface tùy chọn cho service provider đóng góp console commands.
+type CommandProvider interface {
+	// Commands trả về danh sách commands của provider.
+	//
+	// Trả về:
+	//   - []Command: Commands được thêm vào kernel
+	Commands() []Command
+}
+
+// Kernel là console command kernel của application.
+//
+// Kernel parse global flags (--config, --env, --debug), đưa chúng vào
+// "app.config" để applyConfig xử lý khi đăng ký core providers, thu thập
+// commands từ providers implement CommandProvider và chỉ boot các providers
+// mà command cần trước khi chạy command.
+type Kernel struct {
+	app         Application
+	name        string
+	diagnostics []Command
+	commands    map[string]Command
+	stdout      io.Writer
+	stderr      io.Writer
+}
+
+// NewKernel tạo console kernel cho application.
+//
+// Tham số:
+//   - app: Application - Application instance
+//
+// Trả về:
+//   - *Kernel: Kernel instance với DiagnosticCommands(), tên chương trình lấy từ os.Args[0]
+//
+// Ví dụ:
+//
+//	app := core.New(map[string]interface{}{})
+//	app.Register(database.NewServiceProvider())
+//	kernel := core.NewKernel(app)
+//	if err := kernel.Run(context.Background(), os.Args[1:]); err != nil {
+//	    os.Exit(1)
+//	}
+func NewKernel(app Application) *Kernel {
+	name := "app"
+	if len(os.Args) > 0 {
+		name = filepath.Base(os.Args[0])
+	}
+
+	return &Kernel{
+		app:         app,
+		name:        name,
+		diagnostics: DiagnosticCommands(),
+		commands:    make(map[string]Command),
+		stdout:      os.Stdout,
+		stderr:      os.Stderr,
+	}
+}
+
+// SetName đặt tên chương trình hiển thị trong help.
+//
+// Tham số:
+//   - name: string - Tên chương trình
+func (k *Kernel) SetName(name string) {
+	k.name = name
+}
+
+// SetOutput đặt writers cho output và error/help output.
+//
+// Tham số:
+//   - stdout: io.Writer - Writer cho output của commands
+//   - stderr: io.Writer - Writer cho help và thông báo lỗi
+func (k *Kernel) SetOutput(stdout, stderr io.Writer) {
+	k.stdout = stdout
+	k.stderr = stderr
+}
+
+// Add thêm commands vào kernel.
+//
+// Command cùng tên với command đã có sẽ thay thế command cũ.
+//
+// Tham số:
+//   - commands: ...Command - Commands cần thêm
+func (k *Kernel) Add(commands ...Command) {
+	for _, command := range commands {
+		k.commands[command.Name] = command
+	}
+}
+
+// Commands trả về tất cả commands của kernel và providers, sắp xếp theo tên.
+//
+// Trả về:
+//   - []Command: Danh sách commands
+func (k *Kernel) Commands() []Command {
+	all := k.collectCommands()
+	commands := make([]Command, 0, len(all))
+	for _, command := range all {
+		commands = append(commands, command)
+	}
+	sort.Slice(commands, func(i, j int) bool {
+		return commands[i].Name < commands[j].Name
+	})
+	return commands
+}
+
+// Run parse arguments và chạy command tương ứng.
+//
+// Workflow:
+//  1. Parse global flags và đưa vào "app.config"
+//  2. Hiển thị help nếu không có command, command là "help" hoặc có -h
+//  3. Đăng ký core providers và tất cả providers với dependency checking
+//  4. Boot providers cung cấp các services trong Command.Requires
+//  5. Chạy command
+//
+// Tham số:
+//   - ctx: context.Context - Context truyền xuống boot và command
+//   - args: []string - Arguments, thường là os.Args[1:]
+//
+// Trả về:
+//   - error: Lỗi parse flags, ErrCommandNotFound, lỗi bootstrap hoặc lỗi của command
+func (k *Kernel) Run(ctx context.Context, args []string) error {
+	globals := flag.NewFlagSet(k.name, flag.ContinueOnError)
+	globals.SetOutput(io.Discard)
+	configFile := globals.String("config", "", "Đường dẫn file cấu hình")
+	env := globals.String("env", "", "Môi trường chạy, ghi đè app.environment")
+	debug := globals.Bool("debug", false, "Bật debug mode, ghi đè app.debug")
+
+	if err := globals.Parse(args); err != nil {
+		k.printUsage(globals)
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	rest := globals.Args()
+	if len(rest) == 0 {
+		k.printUsage(globals)
+		return nil
+	}
+
+	commands := k.collectCommands()
+	name, rest := rest[0], rest[1:]
+	if name == "help" {
+		if len(rest) == 0 {
+			k.printUsage(globals)
+			return nil
+		}
+		command, exists := commands[rest[0]]
+		if !exists {
+			k.printUsage(globals)
+			return fmt.Errorf("%w: %s", ErrCommandNotFound, rest[0])
+		}
+		k.printCommandUsage(command, k.commandFlags(command))
+		return nil
+	}
+
+	command, exists := commands[name]
+	if !exists {
+		k.printUsage(globals)
+		return fmt.Errorf("%w: %s", ErrCommandNotFound, name)
+	}
+
+	flags := k.commandFlags(command)
+	if err := flags.Parse(rest); err != nil {
+		k.printCommandUsage(command, flags)
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	if err := k.applyGlobalFlags(globals, *configFile, *env, *debug); err != nil {
+		return err
+	}
+
+	if !command.SkipBootstrap {
+		if err := k.bootstrap(ctx, command); err != nil {
+			return err
+		}
+	}
+
+	if command.Run == nil {
+		return fmt.Errorf("command %s has no run function", command.Name)
+	}
+	ctx = context.WithValue(ctx, commandOutputKey{}, k.stdout)
+	return command.Run(ctx, k.app, flags.Args())
+}
+
+// collectCommands gom commands của kernel và của providers implement CommandProvider.
+//
+// Commands của providers được ưu tiên khi trùng tên với DiagnosticCommands(),
+// commands thêm qua Add() sau NewKernel được ưu tiên hơn cả hai.
+//
+// Trả về:
+//   - map[string]Command: Commands theo tên
+func (k *Kernel) collectCommands() map[string]Command {
+	commands := make(map[string]Command)
+	for _, command := range k.diagnostics {
+		commands[command.Name] = command
+	}
+	for _, provider := range k.app.ServiceProviders() {
+		commandProvider, ok := provider.(CommandProvider)
+		if !ok {
+			continue
+		}
+		for _, command := range commandProvider.Commands() {
+			commands[command.Name] = command
+		}
+	}
+	for name, command := range k.commands {
+		commands[name] = command
+	}
+	return commands
+}
+
+// commandFlags tạo FlagSet cho command.
+//
+// Tham số:
+//   - command: Command - Command cần tạo flags
+//
+// Trả về:
+//   - *flag.FlagSet: FlagSet với flags của command
+func (k *Kernel) commandFlags(command Command) *flag.FlagSet {
+	flags := flag.NewFlagSet(command.Name, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	if command.Flags != nil {
+		command.Flags(flags)
+	}
+	return flags
+}
+
+// applyGlobalFlags đưa global flags đã set vào "app.config".
+//
+// Tham số:
+//   - globals: *flag.FlagSet - Global flags đã parse
+//   - configFile: string - Giá trị --config
+//   - env: string - Giá trị --env
+//   - debug: bool - Giá trị --debug
+//
+// Trả về:
+//   - error: Lỗi nếu "app.config" không hợp lệ
+func (k *Kernel) applyGlobalFlags(globals *flag.FlagSet, configFile, env string, debug bool) error {
+	instance, err := k.app.Make("app.config")
+	if err != nil {
+		return fmt.Errorf("app.config not found: %w", err)
+	}
+	cfg, ok := instance.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("invalid app.config type: expected map[string]interface{}, got %T", instance)
+	}
+
+	globals.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "config":
+			cfg["file"] = configFile
+		case "env":
+			cfg["environment"] = env
+		case "debug":
+			cfg["debug"] = debug
+		}
+	})
+	return nil
+}
+
+// bootstrap đăng ký providers và boot các providers mà command cần.
+//
+// Providers được đăng ký như BootstrapApplication(): core providers, plugins
+// trong "app.modules.plugins", kiểm tra manifests rồi register theo dependency
+// order; chỉ bước boot được giới hạn ở Requires của command.
+//
+// Tham số:
+//   - ctx: context.Context - Context boot
+//   - command: Command - Command sắp chạy
+//
+// Trả về:
+//   - error: Lỗi đăng ký hoặc boot providers
+func (k *Kernel) bootstrap(ctx context.Context, command Command) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := registerApplication(ctx, k.app); err != nil {
+		return err
+	}
+	return k.app.BootServicesContext(ctx, command.Requires...)
+}
+
+// printUsage in help tổng quát với danh sách commands và global flags.
+//
+// Tham số:
+//   - globals: *flag.FlagSet - Global flags
+func (k *Kernel) printUsage(globals *flag.FlagSet) {
+	fmt.Fprintf(k.stderr, "Usage: %s [global flags] <command> [flags] [args]\n\n", k.name)
+
+	fmt.Fprintln(k.stderr, "Commands:")
+	w := tabwriter.NewWriter(k.stderr, 0, 0, 3, ' ', 0)
+	fmt.Fprintf(w, "  help\tHiển thị help của command\n")
+	for _, command := range k.Commands() {
+		fmt.Fprintf(w, "  %s\t%s\n", command.Name, command.Description)
+	}
+	_ = w.Flush()
+
+	fmt.Fprintln(k.stderr)
+	fmt.Fprintln(k.stderr, "Global flags:")
+	printFlagDefaults(k.stderr, globals)
+
+	fmt.Fprintf(k.stderr, "\nRun '%s help <command>' for more information on a command.\n", k.name)
+}
+
+// printCommandUsage in help của một command.
+//
+// Tham số:
+//   - command: Command - Command cần in help
+//   - flags: *flag.FlagSet - Flags của command
+func (k *Kernel) printCommandUsage(command Command, flags *flag.FlagSet) {
+	usage := strings.TrimSpace(fmt.Sprintf("%s %s [flags] %s", k.name, command.Name, command.Usage))
+	fmt.Fprintf(k.stderr, "Usage: %s\n", usage)
+	if command.Description != "" {
+		fmt.Fprintf(k.stderr, "\n%s\n", command.Description)
+	}
+
+	hasFlags := false
+	flags.VisitAll(func(*flag.Flag) { hasFlags = true })
+	if hasFlags {
+		fmt.Fprintln(k.stderr)
+		fmt.Fprintln(k.stderr, "Flags:")
+		printFlagDefaults(k.stderr, flags)
+	}
+}
+
+// printFlagDefaults in danh sách flags với mô tả và giá trị mặc định.
+//
+// Tham số:
+//   - out: io.Writer - Writer đích
+//   - flags: *flag.FlagSet - Flags cần in
+func printFlagDefaults(out io.Writer, flags *flag.FlagSet) {
+	flags.SetOutput(out)
+	flags.PrintDefaults()
+	flags.SetOutput(io.Discard)
+}
@@ -0,0 +1,325 @@
+package core_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+	"go.fork.vn/di"
+)
+
+const consoleConfigFile = "testdata/configs/console-only-simple.yaml"
+
+// commandProvider là service provider test implement core.CommandProvider.
+type commandProvider struct {
+	contextProvider
+	commands []core.Command
+	booted   bool
+}
+
+func (p *commandProvider) Commands() []core.Command {
+	return p.commands
+}
+
+// newCommandProvider tạo commandProvider ghi nhận việc boot.
+func newCommandProvider(provides string, requires []string, commands ...core.Command) *commandProvider {
+	provider := &commandProvider{commands: commands}
+	provider.contextProvider = contextProvider{
+		provides: []string{provides},
+		requires: requires,
+		boot: func(ctx context.Context, app di.Application) error {
+			provider.booted = true
+			return nil
+		},
+	}
+	return provider
+}
+
+// newTestKernel tạo kernel ghi output vào buffers.
+func newTestKernel(app core.Application) (*core.Kernel, *bytes.Buffer, *bytes.Buffer) {
+	kernel := core.NewKernel(app)
+	kernel.SetName("forkctl")
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	kernel.SetOutput(stdout, stderr)
+	return kernel, stdout, stderr
+}
+
+// TestKernel_Help tests help generation
+func TestKernel_Help(t *testing.T) {
+	t.Run("lists_kernel_and_provider_commands", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("database", nil, core.Command{
+			Name:        "migrate",
+			Description: "Run database migrations",
+		}))
+
+		kernel, _, stderr := newTestKernel(app)
+		kernel.Add(core.Command{Name: "serve", Description: "Start HTTP server"})
+
+		require.NoError(t, kernel.Run(context.Background(), nil))
+
+		output := stderr.String()
+		assert.Contains(t, output, "Usage: forkctl [global flags] <command>")
+		assert.Contains(t, output, "migrate")
+		assert.Contains(t, output, "Run database migrations")
+		assert.Contains(t, output, "serve")
+		assert.Contains(t, output, "-config")
+		assert.Contains(t, output, "-env")
+		assert.Contains(t, output, "-debug")
+		assert.Less(t, strings.Index(output, "migrate"), strings.Index(output, "serve"))
+	})
+
+	t.Run("shows_command_usage_and_flags", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		kernel, _, stderr := newTestKernel(app)
+		kernel.Add(core.Command{
+			Name:        "migrate",
+			Description: "Run database migrations",
+			Usage:       "[steps]",
+			Flags: func(flags *flag.FlagSet) {
+				flags.Bool("dry-run", false, "Print SQL without executing")
+			},
+		})
+
+		require.NoError(t, kernel.Run(context.Background(), []string{"help", "migrate"}))
+		assert.Contains(t, stderr.String(), "Usage: forkctl migrate [flags] [steps]")
+		assert.Contains(t, stderr.String(), "-dry-run")
+
+		stderr.Reset()
+		require.NoError(t, kernel.Run(context.Background(), []string{"migrate", "-h"}))
+		assert.Contains(t, stderr.String(), "Print SQL without executing")
+	})
+
+	t.Run("global_help_flag", func(t *testing.T) {
+		t.Parallel()
+
+		kernel, _, stderr := newTestKernel(core.New(map[string]interface{}{}))
+		require.NoError(t, kernel.Run(context.Background(), []string{"--help"}))
+		assert.Contains(t, stderr.String(), "Global flags:")
+	})
+
+	t.Run("unknown_command", func(t *testing.T) {
+		t.Parallel()
+
+		kernel, _, stderr := newTestKernel(core.New(map[string]interface{}{}))
+
+		err := kernel.Run(context.Background(), []string{"missing"})
+		assert.ErrorIs(t, err, core.ErrCommandNotFound)
+		assert.Contains(t, stderr.String(), "Commands:")
+
+		err = kernel.Run(context.Background(), []string{"help", "missing"})
+		assert.ErrorIs(t, err, core.ErrCommandNotFound)
+	})
+
+	t.Run("invalid_global_flag", func(t *testing.T) {
+		t.Parallel()
+
+		kernel, _, _ := newTestKernel(core.New(map[string]interface{}{}))
+		assert.Error(t, kernel.Run(context.Background(), []string{"--unknown"}))
+	})
+}
+
+// TestKernel_Run tests command execution
+func TestKernel_Run(t *testing.T) {
+	t.Run("runs_command_with_flags_and_args", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		kernel, stdout, _ := newTestKernel(app)
+
+		var steps int
+		kernel.Add(core.Command{
+			Name: "migrate",
+			Flags: func(flags *flag.FlagSet) {
+				flags.IntVar(&steps, "steps", 1, "Number of steps")
+			},
+			Run: func(ctx context.Context, app core.Application, args []string) error {
+				fmt.Fprintf(core.CommandOutput(ctx), "args=%v", args)
+				return nil
+			},
+		})
+
+		err := kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "migrate", "-steps", "3", "users"})
+		require.NoError(t, err)
+		assert.Equal(t, 3, steps)
+		assert.Equal(t, "args=[users]", stdout.String())
+	})
+
+	t.Run("global_flags_feed_config", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		kernel, _, _ := newTestKernel(app)
+
+		var environment string
+		var debug bool
+		kernel.Add(core.Command{
+			Name:     "env",
+			Requires: []string{"config"},
+			Run: func(ctx context.Context, app core.Application, args []string) error {
+				environment, _ = app.Config().GetString("app.environment")
+				debug, _ = app.Config().GetBool("app.debug")
+				return nil
+			},
+		})
+
+		err := kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "--env", "staging", "--debug", "env"})
+		require.NoError(t, err)
+		assert.Equal(t, "staging", environment)
+		assert.True(t, debug)
+	})
+
+	t.Run("boots_only_required_providers", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		database := newCommandProvider("database", nil)
+		repository := newCommandProvider("repository", []string{"database"})
+		mailer := newCommandProvider("mailer", nil)
+		app.Register(database)
+		app.Register(repository)
+		app.Register(mailer)
+
+		kernel, _, _ := newTestKernel(app)
+		kernel.Add(core.Command{
+			Name:     "users:list",
+			Requires: []string{"repository"},
+			Run: func(ctx context.Context, app core.Application, args []string) error {
+				return nil
+			},
+		})
+
+		require.NoError(t, kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "users:list"}))
+		assert.True(t, database.booted)
+		assert.True(t, repository.booted)
+		assert.False(t, mailer.booted)
+	})
+
+	t.Run("returns_command_error", func(t *testing.T) {
+		t.Parallel()
+
+		commandErr := errors.New("migration failed")
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("database", nil, core.Command{
+			Name: "migrate",
+			Run: func(ctx context.Context, app core.Application, args []string) error {
+				return commandErr
+			},
+		}))
+
+		kernel, _, _ := newTestKernel(app)
+		err := kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "migrate"})
+		assert.ErrorIs(t, err, commandErr)
+	})
+
+	t.Run("returns_bootstrap_error", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		kernel, _, _ := newTestKernel(app)
+		kernel.Add(core.Command{
+			Name:     "serve",
+			Requires: []string{"http"},
+			Run: func(ctx context.Context, app core.Application, args []string) error {
+				t.Error("command must not run when bootstrap fails")
+				return nil
+			},
+		})
+
+		err := kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "serve"})
+		assert.ErrorContains(t, err, "service 'http' not provided")
+
+		err = kernel.Run(context.Background(), []string{"--config", "missing.yaml", "serve"})
+		assert.ErrorContains(t, err, "config read failed")
+	})
+
+	t.Run("bootstraps_like_module_loader", func(t *testing.T) {
+		t.Parallel()
+
+		command := core.Command{
+			Name: "serve",
+			Run: func(ctx context.Context, app core.Application, args []string) error {
+				t.Error("command must not run when bootstrap fails")
+				return nil
+			},
+		}
+
+		kernel, _, _ := newTestKernel(core.New(map[string]interface{}{}))
+		kernel.Add(command)
+		err := kernel.Run(context.Background(), []string{"--config", "testdata/configs/plugins.yaml", "serve"})
+		var pluginErr *core.PluginLoadError
+		require.True(t, errors.As(err, &pluginErr))
+		assert.Equal(t, "testdata/plugins/missing.so", pluginErr.Path)
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newModuleProvider("billing", "1.0.0", map[string]string{"auth": "^1.0.0"}))
+		kernel, _, _ = newTestKernel(app)
+		kernel.Add(command)
+		err = kernel.Run(context.Background(), []string{"--config", consoleConfigFile, "serve"})
+		assert.Equal(t, "auth", compatibilityError(t, err).Conflicts[0].Dependency)
+	})
+}
+
+// TestApplication_BootServicesContext tests partial boot
+func TestApplication_BootServicesContext(t *testing.T) {
+	t.Run("full_boot_skips_already_booted_providers", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		calls := 0
+		database := newLifecycleProvider("database")
+		database.boot = func(ctx context.Context, app di.Application) error {
+			calls++
+			return nil
+		}
+		app.Register(database)
+		app.Register(newLifecycleProvider("mailer"))
+		require.NoError(t, app.RegisterWithDependencies())
+
+		require.NoError(t, app.BootServicesContext(context.Background(), "database"))
+		assert.Equal(t, core.StateCreated, app.State())
+
+		require.NoError(t, app.BootServiceProviders())
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, core.StateBooted, app.State())
+	})
+
+	t.Run("no_services_boots_nothing", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		provider := newCommandProvider("database", nil)
+		app.Register(provider)
+		require.NoError(t, app.RegisterWithDependencies())
+
+		require.NoError(t, app.BootServicesContext(context.Background()))
+		assert.False(t, provider.booted)
+	})
+
+	t.Run("marks_failed_when_boot_fails", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		provider := newLifecycleProvider("database")
+		provider.boot = func(ctx context.Context, app di.Application) error {
+			return errors.New("connection refused")
+		}
+		app.Register(provider)
+		require.NoError(t, app.RegisterWithDependencies())
+
+		assert.Error(t, app.BootServicesContext(context.Background(), "database"))
+		assert.Equal(t, core.StateFailed, app.State())
+	})
+}
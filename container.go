@@ -0,0 +1,752 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"go.fork.vn/di"
+)
+
+// bindingRemover là interface tùy chọn cho container hỗ trợ xóa một binding.
+type bindingRemover interface {
+	// Forget xóa binding, instance và alias của abstract khỏi container.
+	Forget(abstract string)
+}
+
+// bindingDefinition là định nghĩa của một binding: factory, instance hoặc alias.
+type bindingDefinition struct {
+	abstract string
+	concrete di.BindingFunc
+	shared   bool
+	instance interface{}
+	resolved bool
+	alias    string             // Tên service gốc nếu binding là alias
+	revision uint64             // Thứ tự ghi của binding trong bindingContainer
+	previous *bindingDefinition // Definition cùng tên bị binding này che khuất
+	owned    bool               // Binding được tạo trong Register của một provider
+	released bool               // Provider tạo binding đã được gỡ
+}
+
+// binder là các phương thức đăng ký binding chung của di.Container và di.Application.
+type binder interface {
+	Bind(abstract string, concrete di.BindingFunc)
+	Singleton(abstract string, concrete di.BindingFunc)
+	Instance(abstract string, instance interface{})
+	Alias(abstract, alias string)
+}
+
+// apply ghi lại definition vào target.
+//
+// Singletons đã resolve giữ nguyên instance đã resolve; transient bindings và
+// singletons chưa resolve giữ nguyên factory nên vẫn được resolve lazy.
+//
+// Tham số:
+//   - target: binder - Container hoặc application nhận binding
+func (d bindingDefinition) apply(target binder) {
+	switch {
+	case d.alias != "":
+		target.Alias(d.alias, d.abstract)
+	case d.concrete == nil:
+		target.Instance(d.abstract, d.instance)
+	case d.resolved:
+		instance := d.instance
+		target.Singleton(d.abstract, func(di.Container) interface{} { return instance })
+	case d.shared:
+		target.Singleton(d.abstract, d.concrete)
+	default:
+		target.Bind(d.abstract, d.concrete)
+	}
+}
+
+// bindingContainer bọc DI container của application để quản lý bindings.
+//
+// di.Container không có cách xóa binding hay đọc lại định nghĩa của binding,
+// nên bindingContainer giữ bảng definitions của mọi binding và tự resolve từ
+// bảng này; container bên trong chỉ nhận các factory chuyển tiếp về
+// bindingContainer để Call và Bound của nó vẫn hoạt động. bindingContainer ghi
+// nhận:
+//   - Định nghĩa của mỗi binding, để Swap() khôi phục đúng binding gốc
+//   - Services đã bị gỡ: khi container bên trong không implement
+//     bindingRemover, Make trả về lỗi, MustMake panic và Bound trả về false cho
+//     services đó và các aliases trỏ tới chúng cho tới khi chúng được bind lại
+//   - Services mà factory của mỗi binding đã resolve, để Swap() tìm các
+//     services có thể giữ reference tới instance cũ
+//   - Thứ tự ghi của bindings, để so sánh bindings trước và sau một thao tác
+//     như Register của provider
+//   - Definition mà mỗi binding che khuất, để khi gỡ bindings của một provider
+//     thì binding trước đó được khôi phục thay vì bị xóa
+//
+// Bảng definitions không bị sửa tại chỗ: mỗi lần ghi thay bảng bằng bản sao
+// mới, nên nhiều bindings được ghi cùng lúc qua define. Mỗi lần Make dùng bảng
+// tại thời điểm bắt đầu cho cả các lần Make lồng nhau qua container truyền vào
+// factory, nên một resolution không thấy một phần bindings cũ và một phần
+// bindings mới.
+type bindingContainer struct {
+	di.Container
+	mu          sync.RWMutex
+	definitions map[string]*bindingDefinition // Chỉ thay cả bảng khi giữ mu, không sửa tại chỗ
+	forgotten   map[string]bool
+	dependents  map[string][]string
+	revision    uint64
+	tracking    int                   // Số lần ghi nhận bindings của provider đang diễn ra
+	onResolve   func(abstract string) // Gọi khi factory resolve service, gán một lần trước khi dùng
+}
+
+// newBindingContainer bọc container.
+//
+// Tham số:
+//   - container: di.Container - Container bên trong
+//
+// Trả về:
+//   - *bindingContainer: Container đã bọc
+func newBindingContainer(container di.Container) *bindingContainer {
+	return &bindingContainer{
+		Container:   container,
+		definitions: make(map[string]*bindingDefinition),
+		forgotten:   make(map[string]bool),
+		dependents:  make(map[string][]string),
+	}
+}
+
+// Bind đăng ký binding mới, khôi phục abstract nếu đã bị gỡ.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//   - concrete: di.BindingFunc - Factory tạo instance
+func (c *bindingContainer) Bind(abstract string, concrete di.BindingFunc) {
+	c.define(&bindingDefinition{abstract: abstract, concrete: concrete})
+}
+
+// Singleton đăng ký singleton binding, khôi phục abstract nếu đã bị gỡ.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//   - concrete: di.BindingFunc - Factory tạo instance
+func (c *bindingContainer) Singleton(abstract string, concrete di.BindingFunc) {
+	c.define(&bindingDefinition{abstract: abstract, concrete: concrete, shared: true})
+}
+
+// Instance đăng ký instance có sẵn, khôi phục abstract nếu đã bị gỡ.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//   - instance: interface{} - Instance
+func (c *bindingContainer) Instance(abstract string, instance interface{}) {
+	c.define(&bindingDefinition{abstract: abstract, instance: instance, shared: true, resolved: true})
+}
+
+// Alias đăng ký alias cho abstract, khôi phục alias nếu đã bị gỡ.
+//
+// Tham số:
+//   - abstract: string - Tên service gốc
+//   - alias: string - Tên alias
+func (c *bindingContainer) Alias(abstract, alias string) {
+	c.define(&bindingDefinition{abstract: alias, alias: abstract})
+}
+
+// Make resolve abstract, trả về lỗi nếu abstract đã bị gỡ.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - interface{}: Instance đã resolve
+//   - error: Lỗi nếu abstract đã bị gỡ hoặc resolve thất bại
+func (c *bindingContainer) Make(abstract string) (interface{}, error) {
+	c.mu.RLock()
+	table := c.definitions
+	c.mu.RUnlock()
+	return c.resolve(table, abstract)
+}
+
+// MustMake resolve abstract, panic nếu abstract đã bị gỡ hoặc resolve thất bại.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - interface{}: Instance đã resolve
+func (c *bindingContainer) MustMake(abstract string) interface{} {
+	instance, err := c.Make(abstract)
+	if err != nil {
+		panic(err)
+	}
+	return instance
+}
+
+// Bound kiểm tra abstract có được bind và chưa bị gỡ.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - bool: true nếu abstract có thể resolve
+func (c *bindingContainer) Bound(abstract string) bool {
+	return !c.isForgotten(abstract) && c.Container.Bound(abstract)
+}
+
+// Forget gỡ binding của abstract.
+//
+// Dùng Forget của container bên trong nếu có, nếu không abstract được đánh
+// dấu đã gỡ.
+//
+// Tham số:
+//   - abstract: string - Tên service
+func (c *bindingContainer) Forget(abstract string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	table := c.copyDefinitions()
+	c.forget(table, abstract)
+	c.definitions = table
+}
+
+// resolve resolve abstract theo bảng definitions cho trước.
+//
+// Services không được bind qua bindingContainer được resolve bởi container
+// bên trong.
+//
+// Tham số:
+//   - table: map[string]*bindingDefinition - Bảng definitions khi resolution bắt đầu
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - interface{}: Instance đã resolve
+//   - error: Lỗi nếu abstract đã bị gỡ hoặc resolve thất bại
+func (c *bindingContainer) resolve(table map[string]*bindingDefinition, abstract string) (interface{}, error) {
+	if c.isForgotten(abstract) {
+		return nil, fmt.Errorf("service '%s' has been unloaded", abstract)
+	}
+
+	definition, ok := table[abstract]
+	if !ok {
+		return c.Container.Make(abstract)
+	}
+	if definition.alias != "" {
+		return c.resolve(table, definition.alias)
+	}
+
+	c.mu.RLock()
+	instance, resolved := definition.instance, definition.resolved
+	c.mu.RUnlock()
+	if resolved {
+		return instance, nil
+	}
+
+	instance = definition.concrete(&resolvingContainer{bindingContainer: c, parent: abstract, table: table})
+	if definition.shared {
+		c.mu.Lock()
+		if !definition.resolved {
+			definition.instance, definition.resolved = instance, true
+		}
+		instance = definition.instance
+		c.mu.Unlock()
+	}
+	return instance, nil
+}
+
+// copyDefinitions trả về bản sao của bảng definitions để ghi. Caller phải giữ c.mu.
+//
+// Trả về:
+//   - map[string]*bindingDefinition: Bản sao, gán lại vào c.definitions sau khi ghi
+func (c *bindingContainer) copyDefinitions() map[string]*bindingDefinition {
+	table := make(map[string]*bindingDefinition, len(c.definitions)+1)
+	for abstract, definition := range c.definitions {
+		table[abstract] = definition
+	}
+	return table
+}
+
+// forget gỡ binding của abstract khỏi table. Caller phải giữ c.mu.
+//
+// Tham số:
+//   - table: map[string]*bindingDefinition - Bản sao bảng definitions đang ghi
+//   - abstract: string - Tên service
+func (c *bindingContainer) forget(table map[string]*bindingDefinition, abstract string) {
+	if remover, ok := c.Container.(bindingRemover); ok {
+		remover.Forget(abstract)
+		delete(table, abstract)
+		return
+	}
+	c.forgotten[abstract] = true
+}
+
+// release gỡ các bindings do một provider tạo ra.
+//
+// Binding đã bị ghi đè sau đó được giữ nguyên. Binding còn hiệu lực được thay
+// bằng definition gần nhất mà nó che khuất và chưa bị gỡ, hoặc bị gỡ khỏi
+// container nếu không còn definition nào.
+//
+// Tham số:
+//   - definitions: []*bindingDefinition - Definitions của provider, theo thứ tự ghi
+func (c *bindingContainer) release(definitions []*bindingDefinition) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, definition := range definitions {
+		definition.released = true
+	}
+	table := c.copyDefinitions()
+	for i := len(definitions) - 1; i >= 0; i-- {
+		definition := definitions[i]
+		if table[definition.abstract] != definition {
+			continue
+		}
+
+		previous := definition.previous
+		for previous != nil && previous.released {
+			previous = previous.previous
+		}
+		if previous == nil {
+			c.forget(table, definition.abstract)
+			continue
+		}
+		delete(c.forgotten, previous.abstract)
+		table[previous.abstract] = previous
+		c.install(previous)
+	}
+	c.definitions = table
+}
+
+// definition trả về bản sao định nghĩa hiện tại của binding.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - bindingDefinition: Định nghĩa của binding
+//   - bool: false nếu binding không được đăng ký qua bindingContainer
+func (c *bindingContainer) definition(abstract string) (bindingDefinition, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	definition, ok := c.definitions[abstract]
+	if !ok {
+		return bindingDefinition{}, false
+	}
+	return *definition, true
+}
+
+// dependentsOf trả về services có factory đã resolve abstract, trực tiếp hoặc gián tiếp.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - []string: Services phụ thuộc theo thứ tự phát hiện, gần abstract nhất trước
+func (c *bindingContainer) dependentsOf(abstract string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	start := c.canonical(abstract)
+	seen := map[string]bool{start: true}
+	queue := []string{start}
+	result := make([]string, 0)
+	for len(queue) > 0 {
+		service := queue[0]
+		queue = queue[1:]
+		for _, dependent := range c.dependents[service] {
+			if seen[dependent] {
+				continue
+			}
+			seen[dependent] = true
+			result = append(result, dependent)
+			queue = append(queue, dependent)
+		}
+	}
+	return result
+}
+
+// currentRevision trả về thứ tự ghi của binding mới nhất.
+//
+// Trả về:
+//   - uint64: Revision dùng cho boundSince
+func (c *bindingContainer) currentRevision() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.revision
+}
+
+// boundSince trả về services được bind sau revision.
+//
+// Tham số:
+//   - revision: uint64 - Revision lấy từ currentRevision trước thao tác
+//
+// Trả về:
+//   - []string: Services được bind (kể cả ghi đè), theo thứ tự bind lần cuối
+func (c *bindingContainer) boundSince(revision uint64) []string {
+	definitions := c.definitionsSince(revision)
+	services := make([]string, 0, len(definitions))
+	for _, definition := range definitions {
+		services = append(services, definition.abstract)
+	}
+	return services
+}
+
+// trackBindings bắt đầu ghi nhận bindings của một provider.
+//
+// Trả về:
+//   - uint64: Revision truyền vào claimBindings khi thao tác kết thúc
+func (c *bindingContainer) trackBindings() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tracking++
+	return c.revision
+}
+
+// claimBindings kết thúc ghi nhận bắt đầu bởi trackBindings.
+//
+// Definitions còn hiệu lực được ghi sau revision được đánh dấu thuộc provider.
+// Definitions mà chúng che khuất và cũng được ghi sau revision (provider bind
+// cùng tên nhiều lần) được đánh dấu đã gỡ để không bao giờ được khôi phục.
+//
+// Tham số:
+//   - revision: uint64 - Revision trả về bởi trackBindings
+//
+// Trả về:
+//   - []*bindingDefinition: Definitions của provider theo thứ tự ghi, truyền vào release khi gỡ provider
+func (c *bindingContainer) claimBindings(revision uint64) []*bindingDefinition {
+	definitions := c.definitionsSince(revision)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tracking--
+	for _, definition := range definitions {
+		definition.owned = true
+		for previous := definition.previous; previous != nil && previous.revision > revision; previous = previous.previous {
+			previous.owned, previous.released = true, true
+		}
+	}
+	return definitions
+}
+
+// definitionsSince trả về definitions còn hiệu lực được ghi sau revision.
+//
+// Tham số:
+//   - revision: uint64 - Revision lấy từ currentRevision trước thao tác
+//
+// Trả về:
+//   - []*bindingDefinition: Definitions theo thứ tự ghi
+func (c *bindingContainer) definitionsSince(revision uint64) []*bindingDefinition {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	definitions := make([]*bindingDefinition, 0)
+	for _, definition := range c.definitions {
+		if definition.revision > revision {
+			definitions = append(definitions, definition)
+		}
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].revision < definitions[j].revision
+	})
+	return definitions
+}
+
+// define ghi definitions của bindings và bỏ đánh dấu gỡ của chúng.
+//
+// Tất cả definitions được ghi vào bảng mới trong một lần thay bảng, nên Make
+// đồng thời thấy hoặc toàn bộ definitions cũ hoặc toàn bộ definitions mới.
+// Definition hiện tại cùng tên được giữ làm definition bị che khuất.
+// Definition không thuộc provider nào không bao giờ bị gỡ qua release, nên
+// definition mà nó che khuất được bỏ khi không có provider nào đang Register;
+// nhờ đó việc bind lại cùng tên nhiều lần không giữ lại chuỗi definitions cũ.
+//
+// Tham số:
+//   - definitions: ...*bindingDefinition - Định nghĩa bindings mới, theo thứ tự ghi
+func (c *bindingContainer) define(definitions ...*bindingDefinition) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	table := c.copyDefinitions()
+	for _, definition := range definitions {
+		if current, ok := table[definition.abstract]; ok && !c.forgotten[definition.abstract] {
+			if !current.owned && c.tracking == 0 {
+				current.previous = nil
+			}
+			definition.previous = current
+		}
+		delete(c.forgotten, definition.abstract)
+		c.revision++
+		definition.revision = c.revision
+		table[definition.abstract] = definition
+		c.install(definition)
+	}
+	c.definitions = table
+}
+
+// install đăng ký definition vào container bên trong. Caller phải giữ c.mu.
+//
+// Factories và instances được đăng ký dưới dạng factory chuyển tiếp về Make
+// của bindingContainer, nên container bên trong không giữ instance riêng và
+// Call của nó resolve cùng instance với Make.
+//
+// Tham số:
+//   - definition: *bindingDefinition - Definition cần đăng ký
+func (c *bindingContainer) install(definition *bindingDefinition) {
+	abstract := definition.abstract
+	if definition.alias != "" {
+		c.Container.Alias(definition.alias, abstract)
+		return
+	}
+	c.Container.Bind(abstract, func(di.Container) interface{} {
+		instance, _ := c.Make(abstract)
+		return instance
+	})
+}
+
+// recordDependency ghi nhận factory của parent đã resolve service.
+//
+// Tham số:
+//   - parent: string - Service có factory đang chạy
+//   - service: string - Service được resolve
+func (c *bindingContainer) recordDependency(parent, service string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	service = c.canonical(service)
+	for _, dependent := range c.dependents[service] {
+		if dependent == parent {
+			return
+		}
+	}
+	c.dependents[service] = append(c.dependents[service], parent)
+}
+
+// canonical trả về tên service mà alias trỏ tới. Caller phải giữ c.mu.
+//
+// Tham số:
+//   - abstract: string - Tên service hoặc alias
+//
+// Trả về:
+//   - string: Tên service gốc
+func (c *bindingContainer) canonical(abstract string) string {
+	seen := make(map[string]bool)
+	for !seen[abstract] {
+		seen[abstract] = true
+		definition, ok := c.definitions[abstract]
+		if !ok || definition.alias == "" {
+			break
+		}
+		abstract = definition.alias
+	}
+	return abstract
+}
+
+// isForgotten kiểm tra abstract hoặc service mà alias abstract trỏ tới đã bị gỡ.
+//
+// Tham số:
+//   - abstract: string - Tên service hoặc alias
+//
+// Trả về:
+//   - bool: true nếu abstract không được resolve
+func (c *bindingContainer) isForgotten(abstract string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	for name := abstract; !seen[name]; {
+		if c.forgotten[name] {
+			return true
+		}
+		seen[name] = true
+		definition, ok := c.definitions[name]
+		if !ok || definition.alias == "" {
+			break
+		}
+		name = definition.alias
+	}
+	return false
+}
+
+// resolvingContainer là container truyền vào factory của một binding.
+//
+// Make dùng bảng definitions của resolution đang chạy. Mỗi lần Make thành
+// công được ghi nhận là dependency của service có factory đang chạy và được
+// báo cho onResolve của bindingContainer.
+type resolvingContainer struct {
+	*bindingContainer
+	parent string
+	table  map[string]*bindingDefinition
+}
+
+// Make resolve abstract và ghi nhận dependency.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - interface{}: Instance đã resolve
+//   - error: Lỗi nếu resolve thất bại
+func (c *resolvingContainer) Make(abstract string) (interface{}, error) {
+	instance, err := c.resolve(c.table, abstract)
+	if err == nil {
+		c.recordDependency(c.parent, abstract)
+		if c.onResolve != nil {
+			c.onResolve(abstract)
+		}
+	}
+	return instance, err
+}
+
+// MustMake resolve abstract và ghi nhận dependency, panic nếu lỗi.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - interface{}: Instance đã resolve
+func (c *resolvingContainer) MustMake(abstract string) interface{} {
+	instance, err := c.Make(abstract)
+	if err != nil {
+		panic(err)
+	}
+	return instance
+}
+
+// stagedContainer ghi bindings vào staging thay vì container đang chạy.
+//
+// Make ưu tiên bindings trong staging và dùng container đang chạy cho các
+// services còn lại, nên provider có thể register và boot với bindings mới mà
+// các goroutines khác không thấy chúng cho tới khi commit.
+type stagedContainer struct {
+	di.Container
+	mu       sync.Mutex
+	bindings map[string]*bindingDefinition
+	order    []string
+}
+
+// newStagedContainer tạo staging trên container đang chạy.
+//
+// Tham số:
+//   - live: di.Container - Container đang chạy
+//
+// Trả về:
+//   - *stagedContainer: Staging rỗng
+func newStagedContainer(live di.Container) *stagedContainer {
+	return &stagedContainer{Container: live, bindings: make(map[string]*bindingDefinition)}
+}
+
+// Bind ghi binding vào staging.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//   - concrete: di.BindingFunc - Factory tạo instance
+func (c *stagedContainer) Bind(abstract string, concrete di.BindingFunc) {
+	c.stage(&bindingDefinition{abstract: abstract, concrete: concrete})
+}
+
+// Singleton ghi singleton binding vào staging.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//   - concrete: di.BindingFunc - Factory tạo instance
+func (c *stagedContainer) Singleton(abstract string, concrete di.BindingFunc) {
+	c.stage(&bindingDefinition{abstract: abstract, concrete: concrete, shared: true})
+}
+
+// Instance ghi instance vào staging.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//   - instance: interface{} - Instance
+func (c *stagedContainer) Instance(abstract string, instance interface{}) {
+	c.stage(&bindingDefinition{abstract: abstract, instance: instance, shared: true, resolved: true})
+}
+
+// Alias ghi alias vào staging.
+//
+// Tham số:
+//   - abstract: string - Tên service gốc
+//   - alias: string - Tên alias
+func (c *stagedContainer) Alias(abstract, alias string) {
+	c.stage(&bindingDefinition{abstract: alias, alias: abstract})
+}
+
+// Make resolve abstract từ staging, hoặc từ container đang chạy nếu staging không có.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - interface{}: Instance đã resolve
+//   - error: Lỗi nếu resolve thất bại
+func (c *stagedContainer) Make(abstract string) (interface{}, error) {
+	c.mu.Lock()
+	binding, ok := c.bindings[abstract]
+	c.mu.Unlock()
+	switch {
+	case !ok:
+		return c.Container.Make(abstract)
+	case binding.alias != "":
+		return c.Make(binding.alias)
+	case binding.resolved:
+		return binding.instance, nil
+	}
+
+	instance := binding.concrete(c)
+	if binding.shared {
+		c.mu.Lock()
+		if !binding.resolved {
+			binding.instance, binding.resolved = instance, true
+		}
+		instance = binding.instance
+		c.mu.Unlock()
+	}
+	return instance, nil
+}
+
+// MustMake resolve abstract, panic nếu resolve thất bại.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - interface{}: Instance đã resolve
+func (c *stagedContainer) MustMake(abstract string) interface{} {
+	instance, err := c.Make(abstract)
+	if err != nil {
+		panic(err)
+	}
+	return instance
+}
+
+// Bound kiểm tra abstract có trong staging hoặc container đang chạy.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//
+// Trả về:
+//   - bool: true nếu abstract có thể resolve
+func (c *stagedContainer) Bound(abstract string) bool {
+	c.mu.Lock()
+	_, ok := c.bindings[abstract]
+	c.mu.Unlock()
+	return ok || c.Container.Bound(abstract)
+}
+
+// stage ghi binding, binding sau ghi đè binding trước cùng tên.
+//
+// Tham số:
+//   - binding: *bindingDefinition - Binding cần ghi
+func (c *stagedContainer) stage(binding *bindingDefinition) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.bindings[binding.abstract]; !exists {
+		c.order = append(c.order, binding.abstract)
+	}
+	c.bindings[binding.abstract] = binding
+}
+
+// staged trả về bindings trong staging theo thứ tự ghi lần đầu.
+//
+// Trả về:
+//   - []bindingDefinition: Bản sao bindings đã ghi
+func (c *stagedContainer) staged() []bindingDefinition {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	bindings := make([]bindingDefinition, 0, len(c.order))
+	for _, abstract := range c.order {
+		bindings = append(bindings, *c.bindings[abstract])
+	}
+	return bindings
+}
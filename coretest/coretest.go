@@ -0,0 +1,262 @@
+// Package coretest cung cấp test harness cho các applications xây dựng trên go.fork.vn/core.
+//
+// NewApp tạo application với config in-memory (không cần file config trong
+// testdata), log manager ghi lại messages, tự động shutdown qua t.Cleanup và
+// các helpers để thay bindings bằng fakes và kiểm tra thứ tự register/boot
+// của providers.
+//
+// Ví dụ:
+//
+//	func TestBilling(t *testing.T) {
+//	    app := coretest.NewApp(t,
+//	        coretest.WithConfig(map[string]interface{}{"billing": map[string]interface{}{"currency": "VND"}}),
+//	        coretest.WithProviders(database.NewServiceProvider(), billing.NewServiceProvider()),
+//	    )
+//	    app.Fake("mailer", &fakeMailer{})
+//	    app.AssertBootOrder(databaseProvider, billingProvider)
+//	}
+package coretest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"go.fork.vn/core"
+	"go.fork.vn/di"
+)
+
+// defaultLogSettings là config log mặc định khi test không cung cấp "log".
+var defaultLogSettings = map[string]interface{}{
+	"level": 1,
+	"console": map[string]interface{}{
+		"enabled": true,
+		"colored": false,
+	},
+	"file": map[string]interface{}{
+		"enabled": false,
+	},
+	"stack": map[string]interface{}{
+		"enabled": false,
+	},
+}
+
+// Option cấu hình application tạo bởi NewApp.
+type Option func(*options)
+
+// options chứa cấu hình của NewApp.
+type options struct {
+	settings  map[string]interface{}
+	providers []di.ServiceProvider
+	boot      bool
+}
+
+// WithConfig đặt config in-memory cho application.
+//
+// Settings được nạp qua inline config "settings" của core.New, không cần file.
+// Gọi nhiều lần sẽ gộp các keys ở cấp cao nhất, lần gọi sau thắng.
+//
+// Tham số:
+//   - settings: map[string]interface{} - Config dạng nested maps, ví dụ {"app": {"name": "billing"}}
+//
+// Trả về:
+//   - Option: Option cho NewApp
+func WithConfig(settings map[string]interface{}) Option {
+	return func(o *options) {
+		for key, value := range settings {
+			o.settings[key] = value
+		}
+	}
+}
+
+// WithProviders đăng ký providers trước khi bootstrap.
+//
+// Tham số:
+//   - providers: ...di.ServiceProvider - Providers cần đăng ký theo thứ tự
+//
+// Trả về:
+//   - Option: Option cho NewApp
+func WithProviders(providers ...di.ServiceProvider) Option {
+	return func(o *options) {
+		o.providers = append(o.providers, providers...)
+	}
+}
+
+// WithoutBoot tạo application mà không bootstrap, để test tự gọi App.Bootstrap().
+//
+// Trả về:
+//   - Option: Option cho NewApp
+func WithoutBoot() Option {
+	return func(o *options) {
+		o.boot = false
+	}
+}
+
+// App là application dùng trong tests, embed core.Application.
+type App struct {
+	core.Application
+
+	// Logs ghi lại các messages log qua Log(), kể cả messages trong Boot của providers
+	Logs *LogRecorder
+
+	t testing.TB
+}
+
+// NewApp tạo và bootstrap application cho test.
+//
+// Application được shutdown tự động qua t.Cleanup. Test thất bại ngay nếu
+// bootstrap thất bại.
+//
+// Tham số:
+//   - t: testing.TB - Test hiện tại
+//   - opts: ...Option - Các options cấu hình application
+//
+// Trả về:
+//   - *App: Application đã bootstrap (trừ khi dùng WithoutBoot)
+func NewApp(t testing.TB, opts ...Option) *App {
+	t.Helper()
+
+	o := &options{settings: make(map[string]interface{}), boot: true}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if _, ok := o.settings["log"]; !ok {
+		o.settings["log"] = defaultLogSettings
+	}
+
+	app := &App{
+		Application: core.New(map[string]interface{}{"settings": o.settings}),
+		Logs:        &LogRecorder{},
+		t:           t,
+	}
+	for _, provider := range o.providers {
+		app.Register(provider)
+	}
+	app.Register(&logRecorderProvider{recorder: app.Logs})
+
+	t.Cleanup(func() {
+		if err := app.Shutdown(context.Background()); err != nil {
+			t.Errorf("coretest: shutdown failed: %v", err)
+		}
+	})
+
+	if o.boot {
+		if err := app.Bootstrap(); err != nil {
+			t.Fatalf("coretest: bootstrap failed: %v", err)
+		}
+	}
+	return app
+}
+
+// Bootstrap chạy BootstrapApplication().
+//
+// Log manager được thay bằng Logs ngay sau khi register, trước khi providers
+// boot, qua một provider nội bộ require "log" được NewApp đăng ký.
+//
+// Trả về:
+//   - error: Lỗi từ BootstrapApplication()
+func (a *App) Bootstrap() error {
+	return a.ModuleLoader().BootstrapApplication()
+}
+
+// Fake thay binding của abstract bằng fake instance qua Swap().
+//
+// Binding gốc được khôi phục qua t.Cleanup. Services đã resolve trước đó có
+// thể giữ reference tới instance cũ được báo qua t.Logf.
+//
+// Tham số:
+//   - abstract: string - Tên service
+//   - fake: interface{} - Instance thay thế
+//
+// Trả về:
+//   - *core.BindingSwap: Swap đã thực hiện
+func (a *App) Fake(abstract string, fake interface{}) *core.BindingSwap {
+	a.t.Helper()
+
+	swap, err := a.Swap(abstract, fake)
+	if err != nil {
+		a.t.Fatalf("coretest: %v", err)
+	}
+	a.t.Cleanup(swap.Restore)
+	if len(swap.Stale) > 0 {
+		a.t.Logf("coretest: %v resolved before '%s' was faked and may hold stale references", swap.Stale, abstract)
+	}
+	return swap
+}
+
+// AssertRegistered kiểm tra các providers đã được đăng ký vào application.
+//
+// Tham số:
+//   - providers: ...di.ServiceProvider - Providers cần kiểm tra (so sánh theo instance)
+//
+// Trả về:
+//   - bool: true nếu tất cả providers đã được đăng ký
+func (a *App) AssertRegistered(providers ...di.ServiceProvider) bool {
+	a.t.Helper()
+
+	registered := a.ServiceProviders()
+	ok := true
+	for _, provider := range providers {
+		if !containsProvider(registered, provider) {
+			a.t.Errorf("coretest: provider %T is not registered", provider)
+			ok = false
+		}
+	}
+	return ok
+}
+
+// AssertBootOrder kiểm tra các providers đã boot thành công theo đúng thứ tự truyền vào.
+//
+// Providers được so khớp với BootReport theo type name, nên mỗi type chỉ được
+// có một provider trong application.
+//
+// Tham số:
+//   - providers: ...di.ServiceProvider - Providers theo thứ tự boot mong đợi
+//
+// Trả về:
+//   - bool: true nếu tất cả providers đã boot theo thứ tự
+func (a *App) AssertBootOrder(providers ...di.ServiceProvider) bool {
+	a.t.Helper()
+
+	report := a.BootReport()
+	previous, previousName := 0, ""
+	for _, provider := range providers {
+		name := reflect.TypeOf(provider).String()
+
+		var timing *core.ProviderTiming
+		for i := range report.Providers {
+			if report.Providers[i].Name != name {
+				continue
+			}
+			if timing != nil {
+				a.t.Errorf("coretest: provider type %s is registered more than once", name)
+				return false
+			}
+			timing = &report.Providers[i]
+		}
+
+		switch {
+		case timing == nil:
+			a.t.Errorf("coretest: provider %s is not registered", name)
+			return false
+		case !timing.Booted:
+			a.t.Errorf("coretest: provider %s is not booted", name)
+			return false
+		case timing.BootOrder < previous:
+			a.t.Errorf("coretest: provider %s booted before %s", name, previousName)
+			return false
+		}
+		previous, previousName = timing.BootOrder, name
+	}
+	return true
+}
+
+// containsProvider kiểm tra provider instance có trong danh sách.
+func containsProvider(providers []di.ServiceProvider, provider di.ServiceProvider) bool {
+	for _, registered := range providers {
+		if registered == provider {
+			return true
+		}
+	}
+	return false
+}
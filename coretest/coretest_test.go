@@ -0,0 +1,207 @@
+package coretest_test
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+	"go.fork.vn/core/coretest"
+	"go.fork.vn/di"
+	"go.fork.vn/log"
+)
+
+// databaseProvider là service provider test cung cấp "database".
+type databaseProvider struct{}
+
+func (p *databaseProvider) Register(app di.Application) {
+	app.Instance("database", "postgres")
+}
+
+func (p *databaseProvider) Boot(app di.Application) {}
+
+func (p *databaseProvider) Requires() []string { return nil }
+
+func (p *databaseProvider) Providers() []string { return []string{"database"} }
+
+// repositoryProvider là service provider test require "database".
+type repositoryProvider struct{}
+
+func (p *repositoryProvider) Register(app di.Application) {
+	app.Singleton("repository", func(c di.Container) interface{} {
+		return "repository"
+	})
+}
+
+func (p *repositoryProvider) Boot(app di.Application) {}
+
+func (p *repositoryProvider) Requires() []string { return []string{"database"} }
+
+func (p *repositoryProvider) Providers() []string { return []string{"repository"} }
+
+// migrationProvider là service provider test log message khi Boot.
+type migrationProvider struct{}
+
+func (p *migrationProvider) Register(app di.Application) {}
+
+func (p *migrationProvider) Boot(app di.Application) {
+	app.MustMake("log").(log.Manager).Info("ran %d migrations", 2)
+}
+
+func (p *migrationProvider) Requires() []string { return nil }
+
+func (p *migrationProvider) Providers() []string { return nil }
+
+// brokenProvider là service provider test vi phạm contract: bind các services
+// trong binds (qua Container() nếu direct) nhưng khai báo provides và requires.
+type brokenProvider struct {
+	provides []string
+	requires []string
+	binds    []string
+	direct   bool
+}
+
+func (p *brokenProvider) Register(app di.Application) {
+	for _, service := range p.binds {
+		if p.direct {
+			app.Container().Instance(service, service)
+			continue
+		}
+		app.Instance(service, service)
+	}
+}
+
+func (p *brokenProvider) Boot(app di.Application) {}
+
+func (p *brokenProvider) Requires() []string { return p.requires }
+
+func (p *brokenProvider) Providers() []string { return p.provides }
+
+// brokenProviders là các providers vi phạm từng invariant của RunProviderSuite.
+var brokenProviders = map[string]*brokenProvider{
+	"unsatisfied_requires":         {provides: []string{"cache"}, requires: []string{"redis"}, binds: []string{"cache"}},
+	"unbound_declared_service":     {provides: []string{"cache"}},
+	"undeclared_binding":           {provides: []string{"cache"}, binds: []string{"cache", "cache.store"}},
+	"undeclared_container_binding": {provides: []string{"cache"}, binds: []string{"cache", "cache.store"}, direct: true},
+}
+
+// TestNewApp tests the application test harness
+func TestNewApp(t *testing.T) {
+	t.Run("boots_with_in_memory_config", func(t *testing.T) {
+		database, repository := &databaseProvider{}, &repositoryProvider{}
+		app := coretest.NewApp(t,
+			coretest.WithConfig(map[string]interface{}{
+				"app": map[string]interface{}{"name": "billing"},
+			}),
+			coretest.WithProviders(repository, database),
+		)
+
+		name, _ := app.Config().GetString("app.name")
+		assert.Equal(t, "billing", name)
+		assert.Equal(t, core.StateBooted, app.State())
+		assert.True(t, app.AssertRegistered(database, repository))
+		assert.True(t, app.AssertBootOrder(database, repository))
+	})
+
+	t.Run("captures_logs", func(t *testing.T) {
+		app := coretest.NewApp(t)
+		app.Logs.Reset()
+
+		app.Log().Info("charged %d orders", 3)
+		app.Log().Error("gateway timeout")
+
+		assert.True(t, app.Logs.Contains("info", "charged 3 orders"))
+		assert.True(t, app.Logs.Contains("", "gateway timeout"))
+		assert.False(t, app.Logs.Contains("info", "gateway timeout"))
+		require.Len(t, app.Logs.Entries(), 2)
+
+		app.Logs.Reset()
+		assert.Empty(t, app.Logs.Entries())
+	})
+
+	t.Run("captures_logs_during_boot", func(t *testing.T) {
+		app := coretest.NewApp(t, coretest.WithProviders(&migrationProvider{}))
+
+		assert.True(t, app.Logs.Contains("info", "ran 2 migrations"))
+		assert.True(t, app.Logs.Contains("info", "event=boot.end"))
+	})
+
+	t.Run("fakes_bindings", func(t *testing.T) {
+		app := coretest.NewApp(t, coretest.WithProviders(&databaseProvider{}))
+
+		app.Fake("database", "sqlite")
+		assert.Equal(t, "sqlite", app.MustMake("database"))
+	})
+
+	t.Run("defers_bootstrap", func(t *testing.T) {
+		app := coretest.NewApp(t, coretest.WithoutBoot(), coretest.WithProviders(&databaseProvider{}))
+		assert.Equal(t, core.StateCreated, app.State())
+
+		require.NoError(t, app.Bootstrap())
+		assert.Equal(t, core.StateBooted, app.State())
+	})
+}
+
+// TestRunProviderSuite tests the provider conformance suite
+func TestRunProviderSuite(t *testing.T) {
+	coretest.RunProviderSuite(t, &repositoryProvider{}, &databaseProvider{})
+}
+
+// TestRunProviderSuite_Failures tests that the suite fails providers breaking the contract.
+//
+// Subtest thất bại làm test cha thất bại, nên mỗi case chạy suite trong một
+// test process con và kiểm tra subtest thất bại trong output của nó.
+func TestRunProviderSuite_Failures(t *testing.T) {
+	if name := os.Getenv("CORETEST_BROKEN_PROVIDER"); name != "" {
+		if t.Run("suite", func(t *testing.T) {
+			coretest.RunProviderSuite(t, brokenProviders[name], &databaseProvider{})
+		}) {
+			t.Log("suite passed")
+		}
+		return
+	}
+
+	tests := []struct {
+		name    string
+		subtest string
+		message string
+	}{
+		{
+			name:    "unsatisfied_requires",
+			subtest: "requires_are_satisfiable",
+			message: "required service 'redis' is not bound by any dependency",
+		},
+		{
+			name:    "unbound_declared_service",
+			subtest: "register_binds_declared_services",
+			message: "declared service 'cache' is not bound after Register",
+		},
+		{
+			name:    "undeclared_binding",
+			subtest: "register_binds_only_declared_services",
+			message: "Register binds undeclared service 'cache.store'; add it to Providers()",
+		},
+		{
+			name:    "undeclared_container_binding",
+			subtest: "register_binds_only_declared_services",
+			message: "Register binds undeclared service 'cache.store'; add it to Providers()",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := exec.Command(os.Args[0], "-test.run=^TestRunProviderSuite_Failures$", "-test.v")
+			cmd.Env = append(os.Environ(), "CORETEST_BROKEN_PROVIDER="+tt.name)
+			output, err := cmd.CombinedOutput()
+
+			assert.Error(t, err)
+			assert.Contains(t, string(output), "--- FAIL: TestRunProviderSuite_Failures/suite/"+tt.subtest)
+			assert.Contains(t, string(output), tt.message)
+			assert.NotContains(t, string(output), "suite passed")
+		})
+	}
+}
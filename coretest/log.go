@@ -0,0 +1,136 @@
+package coretest
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.fork.vn/di"
+	"go.fork.vn/log"
+)
+
+// LogEntry là một message được ghi lại bởi LogRecorder.
+type LogEntry struct {
+	// Level là mức log: "debug", "info", "warning", "error" hoặc "fatal"
+	Level string
+	// Message là message đã format với args
+	Message string
+}
+
+// LogRecorder là log.Manager ghi lại messages thay vì ghi ra handlers.
+//
+// Các phương thức khác của log.Manager được chuyển tới log manager thật của
+// application. Fatal chỉ ghi lại message, không dừng test process.
+type LogRecorder struct {
+	log.Manager
+
+	mu      sync.Mutex
+	entries []LogEntry
+}
+
+// Debug ghi lại message ở mức debug.
+func (r *LogRecorder) Debug(message string, args ...interface{}) {
+	r.record("debug", message, args)
+}
+
+// Info ghi lại message ở mức info.
+func (r *LogRecorder) Info(message string, args ...interface{}) {
+	r.record("info", message, args)
+}
+
+// Warning ghi lại message ở mức warning.
+func (r *LogRecorder) Warning(message string, args ...interface{}) {
+	r.record("warning", message, args)
+}
+
+// Error ghi lại message ở mức error.
+func (r *LogRecorder) Error(message string, args ...interface{}) {
+	r.record("error", message, args)
+}
+
+// Fatal ghi lại message ở mức fatal.
+func (r *LogRecorder) Fatal(message string, args ...interface{}) {
+	r.record("fatal", message, args)
+}
+
+// Entries trả về các messages đã ghi lại.
+//
+// Trả về:
+//   - []LogEntry: Bản sao các entries theo thứ tự ghi
+func (r *LogRecorder) Entries() []LogEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]LogEntry(nil), r.entries...)
+}
+
+// Contains kiểm tra có message ở level chứa substring.
+//
+// Tham số:
+//   - level: string - Mức log, rỗng để tìm ở mọi level
+//   - substring: string - Chuỗi cần tìm trong message
+//
+// Trả về:
+//   - bool: true nếu tìm thấy
+func (r *LogRecorder) Contains(level, substring string) bool {
+	for _, entry := range r.Entries() {
+		if (level == "" || entry.Level == level) && strings.Contains(entry.Message, substring) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset xóa các messages đã ghi lại.
+func (r *LogRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = nil
+}
+
+// record format và ghi lại message.
+func (r *LogRecorder) record(level, message string, args []interface{}) {
+	if len(args) > 0 {
+		message = fmt.Sprintf(message, args...)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, LogEntry{Level: level, Message: message})
+}
+
+// logRecorderProvider thay log manager bằng LogRecorder trước khi providers boot.
+//
+// Provider require "log" nên được register sau provider cung cấp log manager
+// và trước khi bất kỳ provider nào boot, để messages log trong Boot cũng được
+// ghi lại.
+type logRecorderProvider struct {
+	recorder *LogRecorder
+}
+
+// Register bọc log manager thật trong recorder và bind recorder vào "log".
+func (p *logRecorderProvider) Register(app di.Application) {
+	instance, err := app.Make("log")
+	if err != nil {
+		return
+	}
+	if _, recorded := instance.(*LogRecorder); recorded {
+		return
+	}
+	if manager, ok := instance.(log.Manager); ok {
+		p.recorder.Manager = manager
+		app.Instance("log", p.recorder)
+	}
+}
+
+// Boot không làm gì; recorder đã được bind khi Register.
+func (p *logRecorderProvider) Boot(app di.Application) {}
+
+// Requires trả về "log" để provider được register sau log manager.
+func (p *logRecorderProvider) Requires() []string {
+	return []string{"log"}
+}
+
+// Providers trả về rỗng vì provider chỉ thay instance của "log".
+func (p *logRecorderProvider) Providers() []string {
+	return nil
+}
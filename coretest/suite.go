@@ -0,0 +1,124 @@
+package coretest
+
+import (
+	"fmt"
+	"testing"
+
+	"go.fork.vn/core"
+	"go.fork.vn/di"
+)
+
+// RunProviderSuite kiểm tra provider tuân thủ contract của di.ServiceProvider.
+//
+// Dependencies được bootstrap trước qua NewApp, sau đó suite chạy các subtests:
+//   - requires_are_satisfiable: Mỗi service trong Requires() đã được bind bởi deps
+//   - register_binds_declared_services: Mỗi service trong Providers() được bind trong Register
+//   - register_binds_only_declared_services: Register không bind service ngoài Providers()
+//   - declared_services_resolve: Mỗi service trong Providers() resolve được sau Boot
+//   - boot_is_idempotent: Boot gọi hai lần không panic và services vẫn resolve được
+//
+// Bindings được so sánh trên container trước và sau Register qua
+// core.BindingsDuring, nên bindings qua Container() trực tiếp cũng được kiểm tra.
+//
+// Tham số:
+//   - t: *testing.T - Test hiện tại
+//   - provider: di.ServiceProvider - Provider cần kiểm tra
+//   - deps: ...di.ServiceProvider - Providers cung cấp các services mà provider require
+//
+// Ví dụ:
+//
+//	func TestCacheProvider(t *testing.T) {
+//	    coretest.RunProviderSuite(t, cache.NewServiceProvider(), redis.NewServiceProvider())
+//	}
+func RunProviderSuite(t *testing.T, provider di.ServiceProvider, deps ...di.ServiceProvider) {
+	t.Helper()
+
+	app := NewApp(t, WithProviders(deps...))
+	var bound []string
+
+	t.Run("requires_are_satisfiable", func(t *testing.T) {
+		for _, service := range provider.Requires() {
+			if !app.Container().Bound(service) {
+				t.Errorf("required service '%s' is not bound by any dependency", service)
+			}
+		}
+	})
+
+	registered := t.Run("register_binds_declared_services", func(t *testing.T) {
+		var panicked error
+		tracked, err := core.BindingsDuring(app, func() {
+			panicked = protect(func() { provider.Register(app) })
+		})
+		if err != nil {
+			t.Fatalf("cannot track bindings: %v", err)
+		}
+		if panicked != nil {
+			t.Fatalf("Register panicked: %v", panicked)
+		}
+
+		bound = tracked
+		isBound := make(map[string]bool, len(bound))
+		for _, service := range bound {
+			isBound[service] = true
+		}
+		for _, service := range provider.Providers() {
+			if !isBound[service] {
+				t.Errorf("declared service '%s' is not bound after Register", service)
+			}
+		}
+	})
+	if !registered {
+		return
+	}
+
+	t.Run("register_binds_only_declared_services", func(t *testing.T) {
+		declared := make(map[string]bool)
+		for _, service := range provider.Providers() {
+			declared[service] = true
+		}
+		for _, service := range bound {
+			if !declared[service] {
+				t.Errorf("Register binds undeclared service '%s'; add it to Providers()", service)
+			}
+		}
+	})
+
+	t.Run("declared_services_resolve", func(t *testing.T) {
+		if err := protect(func() { provider.Boot(app) }); err != nil {
+			t.Fatalf("Boot panicked: %v", err)
+		}
+		for _, service := range provider.Providers() {
+			if _, err := app.Make(service); err != nil {
+				t.Errorf("declared service '%s' cannot be resolved: %v", service, err)
+			}
+		}
+	})
+
+	t.Run("boot_is_idempotent", func(t *testing.T) {
+		if err := protect(func() { provider.Boot(app) }); err != nil {
+			t.Fatalf("second Boot panicked: %v", err)
+		}
+		for _, service := range provider.Providers() {
+			if _, err := app.Make(service); err != nil {
+				t.Errorf("declared service '%s' cannot be resolved after second Boot: %v", service, err)
+			}
+		}
+	})
+}
+
+// protect chạy fn và chuyển panic thành error.
+//
+// Tham số:
+//   - fn: func() - Hàm cần chạy
+//
+// Trả về:
+//   - error: Lỗi mô tả panic, nil nếu fn không panic
+func protect(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	fn()
+	return nil
+}
@@ -0,0 +1,168 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// maxServiceSuggestions là số services gợi ý tối đa cho mỗi service bị thiếu.
+const maxServiceSuggestions = 3
+
+// MissingDependency mô tả một service được require nhưng không provider nào cung cấp.
+type MissingDependency struct {
+	// Provider là tên (type) của provider require service
+	Provider string
+	// Service là tên service bị thiếu
+	Service string
+	// Suggestions là các services đã được cung cấp có tên gần giống, sắp xếp theo độ tương đồng
+	Suggestions []string
+}
+
+// String trả về mô tả một dòng của missing dependency.
+//
+// Trả về:
+//   - string: Mô tả kèm gợi ý nếu có
+func (m MissingDependency) String() string {
+	message := fmt.Sprintf("required service '%s' not provided by any registered provider (required by %s)", m.Service, m.Provider)
+	if len(m.Suggestions) > 0 {
+		message += "; did you mean " + quoteServices(m.Suggestions) + "?"
+	}
+	return message
+}
+
+// MissingDependenciesError chứa tất cả services bị thiếu tìm được khi sắp xếp providers.
+type MissingDependenciesError struct {
+	// Missing là danh sách services bị thiếu theo thứ tự đăng ký của providers
+	Missing []MissingDependency
+}
+
+// Error implement error interface.
+//
+// Trả về:
+//   - string: Mô tả missing dependency duy nhất, hoặc danh sách tất cả trên từng dòng
+func (e *MissingDependenciesError) Error() string {
+	if len(e.Missing) == 1 {
+		return e.Missing[0].String()
+	}
+
+	lines := make([]string, 0, len(e.Missing)+1)
+	lines = append(lines, fmt.Sprintf("%d required services not provided by any registered provider:", len(e.Missing)))
+	for _, missing := range e.Missing {
+		lines = append(lines, "  - "+missing.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// suggestServices tìm các services đã được cung cấp có tên gần giống service bị thiếu.
+//
+// Service được gợi ý khi edit distance (không phân biệt hoa thường) không vượt
+// quá một phần ba độ dài tên (tối thiểu 1), hoặc khi một tên chứa tên kia
+// (tên bị chứa dài ít nhất 3 ký tự).
+//
+// Tham số:
+//   - missing: string - Tên service bị thiếu
+//   - provided: []string - Tên các services đã được cung cấp
+//
+// Trả về:
+//   - []string: Tối đa maxServiceSuggestions services, gần giống nhất trước
+func suggestServices(missing string, provided []string) []string {
+	type candidate struct {
+		service  string
+		distance int
+	}
+
+	target := strings.ToLower(missing)
+	threshold := len(target) / 3
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	candidates := make([]candidate, 0)
+	seen := make(map[string]bool)
+	for _, service := range provided {
+		if seen[service] || service == missing {
+			continue
+		}
+		seen[service] = true
+
+		name := strings.ToLower(service)
+		distance := levenshtein(target, name)
+		if distance <= threshold || containsName(name, target) || containsName(target, name) {
+			candidates = append(candidates, candidate{service: service, distance: distance})
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].service < candidates[j].service
+	})
+
+	suggestions := make([]string, 0, maxServiceSuggestions)
+	for _, c := range candidates {
+		if len(suggestions) == maxServiceSuggestions {
+			break
+		}
+		suggestions = append(suggestions, c.service)
+	}
+	return suggestions
+}
+
+// containsName kiểm tra name chứa part với part đủ dài để gợi ý có ý nghĩa.
+//
+// Tham số:
+//   - name: string - Tên đầy đủ
+//   - part: string - Tên cần tìm trong name
+//
+// Trả về:
+//   - bool: true nếu part dài ít nhất 3 ký tự và nằm trong name
+func containsName(name, part string) bool {
+	return len(part) >= 3 && strings.Contains(name, part)
+}
+
+// levenshtein tính edit distance giữa hai chuỗi.
+//
+// Tham số:
+//   - a: string - Chuỗi thứ nhất
+//   - b: string - Chuỗi thứ hai
+//
+// Trả về:
+//   - int: Số thao tác chèn, xóa hoặc thay thế ký tự tối thiểu
+func levenshtein(a, b string) int {
+	source, target := []rune(a), []rune(b)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
+
+// quoteServices nối tên services trong dấu nháy đơn.
+//
+// Tham số:
+//   - services: []string - Tên services
+//
+// Trả về:
+//   - string: Ví dụ "'db', 'database'"
+func quoteServices(services []string) string {
+	quoted := make([]string, len(services))
+	for i, service := range services {
+		quoted[i] = "'" + service + "'"
+	}
+	return strings.Join(quoted, ", ")
+}
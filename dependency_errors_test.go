@@ -0,0 +1,82 @@
+package core_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+)
+
+// TestMissingDependenciesError tests aggregation of missing dependencies
+func TestMissingDependenciesError(t *testing.T) {
+	t.Run("collects_every_missing_service", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("database", nil))
+		app.Register(newCommandProvider("repository", []string{"databse", "cache"}))
+		app.Register(newCommandProvider("mailer", []string{"queue"}))
+
+		err := app.RegisterWithDependencies()
+		require.Error(t, err)
+
+		var missingErr *core.MissingDependenciesError
+		require.True(t, errors.As(err, &missingErr))
+		require.Len(t, missingErr.Missing, 3)
+
+		assert.Equal(t, core.MissingDependency{
+			Provider:    "*core_test.commandProvider",
+			Service:     "databse",
+			Suggestions: []string{"database"},
+		}, missingErr.Missing[0])
+		assert.Equal(t, "cache", missingErr.Missing[1].Service)
+		assert.Empty(t, missingErr.Missing[1].Suggestions)
+		assert.Equal(t, "queue", missingErr.Missing[2].Service)
+
+		lines := strings.Split(err.Error(), "\n")
+		require.Len(t, lines, 4)
+		assert.Equal(t, "3 required services not provided by any registered provider:", lines[0])
+		assert.Equal(t, "  - required service 'databse' not provided by any registered provider (required by *core_test.commandProvider); did you mean 'database'?", lines[1])
+	})
+
+	t.Run("single_missing_service_message", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("repository", []string{"database"}))
+
+		err := app.RegisterWithDependencies()
+		assert.EqualError(t, err, "required service 'database' not provided by any registered provider (required by *core_test.commandProvider)")
+	})
+
+	t.Run("suggestions_are_ranked_by_similarity", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("logger", nil))
+		app.Register(newCommandProvider("log.manager", nil))
+		app.Register(newCommandProvider("cache", nil))
+		app.Register(newCommandProvider("api", []string{"loger"}))
+
+		err := app.RegisterWithDependencies()
+
+		var missingErr *core.MissingDependenciesError
+		require.True(t, errors.As(err, &missingErr))
+		assert.Equal(t, []string{"logger"}, missingErr.Missing[0].Suggestions)
+	})
+
+	t.Run("plan_includes_suggestions", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("database", nil))
+		app.Register(newCommandProvider("repository", []string{"Database"}))
+
+		errs := app.Plan().Errors()
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Message, "did you mean 'database'?")
+	})
+}
@@ -0,0 +1,310 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.fork.vn/di"
+)
+
+// missingService mô tả một service được require nhưng không provider nào cung cấp.
+type missingService struct {
+	// provider là key của provider require service
+	provider string
+	// service là tên service bị thiếu
+	service string
+}
+
+// providerGraph là dependency graph giữa các service providers.
+//
+// Graph được xây dựng chỉ từ Providers() và Requires() nên không gây side
+// effects: không provider nào được Register hay Boot. Đây là dependency graph
+// duy nhất của package: RegisterWithDependencies, LoadProviders, ReplaceProvider,
+// BootServicesContext, Plan, BootReport và các diagnostic commands đều dùng nó
+// nên luôn cho cùng một kết quả.
+type providerGraph struct {
+	// keys là provider keys theo thứ tự đăng ký, không trùng lặp
+	keys []string
+	// providers map provider key tới provider
+	providers map[string]di.ServiceProvider
+	// services map service name tới provider key cung cấp service (provider đăng ký sau thắng)
+	services map[string]string
+	// dependsOn map provider key tới các provider keys mà nó phụ thuộc
+	dependsOn map[string][]string
+	// missing là các services được require nhưng không được cung cấp
+	missing []missingService
+	// serviceProviders map service name tới tất cả provider keys cung cấp service, theo thứ tự đăng ký
+	serviceProviders map[string][]string
+	// duplicates là provider keys được đăng ký nhiều lần
+	duplicates []string
+}
+
+// newProviderGraph xây dựng dependency graph từ danh sách providers.
+//
+// Tham số:
+//   - providers: []di.ServiceProvider - Providers theo thứ tự đăng ký
+//
+// Trả về:
+//   - *providerGraph: Dependency graph
+func newProviderGraph(providers []di.ServiceProvider) *providerGraph {
+	g := &providerGraph{
+		keys:             make([]string, 0, len(providers)),
+		providers:        make(map[string]di.ServiceProvider),
+		services:         make(map[string]string),
+		dependsOn:        make(map[string][]string),
+		serviceProviders: make(map[string][]string),
+	}
+
+	for _, provider := range providers {
+		key := getProviderKey(provider)
+		if _, exists := g.providers[key]; exists {
+			g.duplicates = append(g.duplicates, key)
+			continue
+		}
+		g.keys = append(g.keys, key)
+		g.providers[key] = provider
+		for _, service := range provider.Providers() {
+			g.services[service] = key
+			g.serviceProviders[service] = append(g.serviceProviders[service], key)
+		}
+	}
+
+	for _, key := range g.keys {
+		seen := make(map[string]bool)
+		for _, service := range g.providers[key].Requires() {
+			dependency, exists := g.services[service]
+			if !exists {
+				g.missing = append(g.missing, missingService{provider: key, service: service})
+				continue
+			}
+			if seen[dependency] {
+				continue
+			}
+			seen[dependency] = true
+			g.dependsOn[key] = append(g.dependsOn[key], dependency)
+		}
+	}
+
+	return g
+}
+
+// levels nhóm providers theo dependency level bằng Kahn's algorithm.
+//
+// Requires bị thiếu được bỏ qua. Providers nằm trong (hoặc phụ thuộc vào)
+// một cycle không thuộc level nào và được trả về riêng.
+//
+// Trả về:
+//   - [][]string: Provider keys theo level, giữ thứ tự đăng ký trong mỗi level
+//   - []string: Provider keys không thể sắp xếp do circular dependency
+func (g *providerGraph) levels() ([][]string, []string) {
+	order := make(map[string]int, len(g.keys))
+	inDegree := make(map[string]int, len(g.keys))
+	dependents := make(map[string][]string)
+	for index, key := range g.keys {
+		order[key] = index
+		inDegree[key] = len(g.dependsOn[key])
+		for _, dependency := range g.dependsOn[key] {
+			dependents[dependency] = append(dependents[dependency], key)
+		}
+	}
+
+	current := make([]string, 0)
+	for _, key := range g.keys {
+		if inDegree[key] == 0 {
+			current = append(current, key)
+		}
+	}
+
+	levels := make([][]string, 0)
+	placed := make(map[string]bool, len(g.keys))
+	for len(current) > 0 {
+		next := make([]string, 0)
+		for _, key := range current {
+			placed[key] = true
+			for _, dependent := range dependents[key] {
+				inDegree[dependent]--
+				if inDegree[dependent] == 0 {
+					next = append(next, dependent)
+				}
+			}
+		}
+		sort.Slice(next, func(i, j int) bool {
+			return order[next[i]] < order[next[j]]
+		})
+		levels = append(levels, current)
+		current = next
+	}
+
+	unsorted := make([]string, 0)
+	for _, key := range g.keys {
+		if !placed[key] {
+			unsorted = append(unsorted, key)
+		}
+	}
+	return levels, unsorted
+}
+
+// sortedLevels sắp xếp toàn bộ providers theo dependency level.
+//
+// Trả về:
+//   - [][]di.ServiceProvider: Providers nhóm theo level, giữ thứ tự đăng ký trong mỗi level
+//   - error: *MissingDependenciesError chứa tất cả services bị thiếu, hoặc lỗi circular dependency
+func (g *providerGraph) sortedLevels() ([][]di.ServiceProvider, error) {
+	if err := g.missingError(nil); err != nil {
+		return nil, err
+	}
+
+	keys, unsorted := g.levels()
+	if len(unsorted) > 0 {
+		return nil, g.cycleError(nil)
+	}
+
+	levels := make([][]di.ServiceProvider, 0, len(keys))
+	for _, level := range keys {
+		providers := make([]di.ServiceProvider, 0, len(level))
+		for _, key := range level {
+			providers = append(providers, g.providers[key])
+		}
+		levels = append(levels, providers)
+	}
+	return levels, nil
+}
+
+// missingError kiểm tra services mà providers trong batch require.
+//
+// Tham số:
+//   - batch: map[string]bool - Provider keys cần kiểm tra, nil để kiểm tra mọi providers
+//
+// Trả về:
+//   - error: *MissingDependenciesError nếu có service bị thiếu
+func (g *providerGraph) missingError(batch map[string]bool) error {
+	provided := make([]string, 0, len(g.services))
+	for service := range g.services {
+		provided = append(provided, service)
+	}
+
+	missing := make([]MissingDependency, 0)
+	for _, m := range g.missing {
+		if batch != nil && !batch[m.provider] {
+			continue
+		}
+		missing = append(missing, MissingDependency{
+			Provider:    g.name(m.provider),
+			Service:     m.service,
+			Suggestions: suggestServices(m.service, provided),
+		})
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+	return &MissingDependenciesError{Missing: missing}
+}
+
+// cycleError tạo lỗi circular dependency cho các cycles có provider thuộc batch.
+//
+// Tham số:
+//   - batch: map[string]bool - Provider keys cần kiểm tra, nil để báo mọi cycles
+//
+// Trả về:
+//   - error: Lỗi mô tả chuỗi phụ thuộc vòng
+func (g *providerGraph) cycleError(batch map[string]bool) error {
+	chains := make([]string, 0)
+	for _, cycle := range g.cycles() {
+		involved := batch == nil
+		names := make([]string, 0, len(cycle)+1)
+		for _, key := range cycle {
+			involved = involved || batch[key]
+			names = append(names, g.name(key))
+		}
+		if !involved {
+			continue
+		}
+		names = append(names, g.name(cycle[0]))
+		chains = append(chains, strings.Join(names, " -> "))
+	}
+	if len(chains) == 0 {
+		return fmt.Errorf("circular dependency detected among service providers")
+	}
+	return fmt.Errorf("circular dependency detected among service providers: %s", strings.Join(chains, "; "))
+}
+
+// cycles tìm các circular dependencies trong graph.
+//
+// Mỗi cycle được trả về dưới dạng danh sách provider keys theo chiều phụ
+// thuộc, bắt đầu từ provider được duyệt đầu tiên trong cycle; mỗi tập providers
+// tạo thành cycle chỉ được báo một lần.
+//
+// Trả về:
+//   - [][]string: Các cycles tìm được
+func (g *providerGraph) cycles() [][]string {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	state := make(map[string]int, len(g.keys))
+	stack := make([]string, 0)
+	reported := make(map[string]bool)
+	cycles := make([][]string, 0)
+
+	var visit func(key string)
+	visit = func(key string) {
+		state[key] = visiting
+		stack = append(stack, key)
+
+		for _, dependency := range g.dependsOn[key] {
+			switch state[dependency] {
+			case unvisited:
+				visit(dependency)
+			case visiting:
+				start := len(stack) - 1
+				for stack[start] != dependency {
+					start--
+				}
+				cycle := append([]string(nil), stack[start:]...)
+				signature := cycleSignature(cycle)
+				if !reported[signature] {
+					reported[signature] = true
+					cycles = append(cycles, cycle)
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[key] = done
+	}
+
+	for _, key := range g.keys {
+		if state[key] == unvisited {
+			visit(key)
+		}
+	}
+	return cycles
+}
+
+// cycleSignature tạo chuỗi định danh cho tập providers của một cycle.
+//
+// Tham số:
+//   - cycle: []string - Provider keys trong cycle
+//
+// Trả về:
+//   - string: Keys đã sắp xếp, nối bằng dấu phẩy
+func cycleSignature(cycle []string) string {
+	keys := append([]string(nil), cycle...)
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// name trả về tên dễ đọc của provider theo key.
+//
+// Tham số:
+//   - key: string - Provider key
+//
+// Trả về:
+//   - string: Tên provider
+func (g *providerGraph) name(key string) string {
+	return providerName(g.providers[key])
+}
@@ -0,0 +1,243 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultHealthCheckTimeout là timeout mặc định cho mỗi health check.
+const defaultHealthCheckTimeout = 5 * time.Second
+
+// HealthCheckKind phân loại health check.
+type HealthCheckKind string
+
+const (
+	// LivenessCheck kiểm tra process còn hoạt động, lỗi nghĩa là cần restart
+	LivenessCheck HealthCheckKind = "liveness"
+	// ReadinessCheck kiểm tra application sẵn sàng nhận traffic
+	ReadinessCheck HealthCheckKind = "readiness"
+)
+
+// HealthStatus là kết quả của một health check hoặc của toàn bộ application.
+type HealthStatus string
+
+const (
+	// HealthUp nghĩa là check thành công
+	HealthUp HealthStatus = "up"
+	// HealthDown nghĩa là check thất bại
+	HealthDown HealthStatus = "down"
+)
+
+// HealthCheck định nghĩa một health check do provider cung cấp.
+//
+// Fields:
+//   - Name: Tên check, hiển thị trong report
+//   - Kind: LivenessCheck hoặc ReadinessCheck
+//   - Timeout: Thời gian tối đa cho check, 0 để dùng "app.health.timeout" (mặc định 5s)
+//   - Check: Hàm kiểm tra, trả về error nếu không healthy
+type HealthCheck struct {
+	Name    string
+	Kind    HealthCheckKind
+	Timeout time.Duration
+	Check   func(ctx context.Context) error
+}
+
+// HealthChecker là interface tùy chọn cho service provider cung cấp health checks.
+//
+// Application.Health() thu thập checks từ tất cả providers đã boot implement
+// interface này và chạy chúng đồng thời.
+type HealthChecker interface {
+	// HealthChecks trả về danh sách health checks của provider.
+	//
+	// Trả về:
+	//   - []HealthCheck: Liveness và readiness checks
+	HealthChecks() []HealthCheck
+}
+
+// HealthCheckResult là kết quả chạy một health check.
+type HealthCheckResult struct {
+	// Name là tên check
+	Name string `json:"name"`
+	// Provider là tên (type) của provider cung cấp check
+	Provider string `json:"provider"`
+	// Kind là loại check
+	Kind HealthCheckKind `json:"kind"`
+	// Status là kết quả check
+	Status HealthStatus `json:"status"`
+	// Error là error message nếu check thất bại
+	Error string `json:"error,omitempty"`
+	// Duration là thời gian chạy check
+	Duration time.Duration `json:"duration"`
+}
+
+// HealthReport là kết quả tổng hợp health của application.
+type HealthReport struct {
+	// Status là HealthUp khi application vừa live vừa ready
+	Status HealthStatus `json:"status"`
+	// Live cho biết tất cả liveness checks thành công
+	Live bool `json:"live"`
+	// Ready cho biết application đã boot xong, chưa shutdown và tất cả readiness checks thành công
+	Ready bool `json:"ready"`
+	// State là trạng thái lifecycle tại thời điểm check
+	State string `json:"state"`
+	// CheckedAt là thời điểm chạy checks
+	CheckedAt time.Time `json:"checked_at"`
+	// Checks chứa kết quả từng check theo thứ tự boot của providers
+	Checks []HealthCheckResult `json:"checks"`
+}
+
+// Health chạy health checks của tất cả providers và trả về report tổng hợp.
+//
+// Implement Application interface method.
+//
+// Checks chỉ được chạy khi application ở trạng thái StateBooted; trước khi
+// BootServiceProviders hoàn tất và trong lúc shutdown, readiness luôn là false
+// và không check nào được chạy. Các checks chạy đồng thời, mỗi check có timeout
+// riêng; panic trong check được chuyển thành kết quả HealthDown.
+//
+// Tham số:
+//   - ctx: context.Context - Context giới hạn thời gian chạy checks
+//
+// Trả về:
+//   - *HealthReport: Report tổng hợp
+func (a *application) Health(ctx context.Context) *HealthReport {
+	state := a.State()
+	report := &HealthReport{
+		Live:      state != StateShutdown,
+		State:     state.String(),
+		CheckedAt: time.Now(),
+		Checks:    make([]HealthCheckResult, 0),
+	}
+
+	if state == StateBooted {
+		report.Checks = a.runHealthChecks(ctx)
+		report.Ready = true
+		for _, result := range report.Checks {
+			if result.Status == HealthUp {
+				continue
+			}
+			switch result.Kind {
+			case LivenessCheck:
+				report.Live = false
+			case ReadinessCheck:
+				report.Ready = false
+			}
+		}
+	}
+
+	report.Status = HealthDown
+	if report.Live && report.Ready {
+		report.Status = HealthUp
+	}
+	return report
+}
+
+// runHealthChecks chạy đồng thời health checks của các providers đã boot.
+//
+// Tham số:
+//   - ctx: context.Context - Context giới hạn thời gian chạy checks
+//
+// Trả về:
+//   - []HealthCheckResult: Kết quả theo thứ tự boot của providers
+func (a *application) runHealthChecks(ctx context.Context) []HealthCheckResult {
+	providers := a.bootOrder()
+
+	type pendingCheck struct {
+		provider string
+		check    HealthCheck
+	}
+
+	checks := make([]pendingCheck, 0)
+	for _, provider := range providers {
+		checker, ok := provider.(HealthChecker)
+		if !ok {
+			continue
+		}
+		for _, check := range checker.HealthChecks() {
+			checks = append(checks, pendingCheck{provider: providerName(provider), check: check})
+		}
+	}
+
+	defaultTimeout := a.healthCheckTimeout()
+	results := make([]HealthCheckResult, len(checks))
+
+	var wg sync.WaitGroup
+	for i, pending := range checks {
+		wg.Add(1)
+		go func(i int, provider string, check HealthCheck) {
+			defer wg.Done()
+			results[i] = runHealthCheck(ctx, provider, check, defaultTimeout)
+		}(i, pending.provider, pending.check)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// runHealthCheck chạy một health check với timeout.
+//
+// Tham số:
+//   - ctx: context.Context - Context cha
+//   - provider: string - Tên provider cung cấp check
+//   - check: HealthCheck - Check cần chạy
+//   - defaultTimeout: time.Duration - Timeout khi check không khai báo timeout
+//
+// Trả về:
+//   - HealthCheckResult: Kết quả check
+func runHealthCheck(ctx context.Context, provider string, check HealthCheck, defaultTimeout time.Duration) HealthCheckResult {
+	result := HealthCheckResult{
+		Name:     check.Name,
+		Provider: provider,
+		Kind:     check.Kind,
+		Status:   HealthUp,
+	}
+	if result.Kind == "" {
+		result.Kind = ReadinessCheck
+	}
+
+	timeout := check.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	var err error
+	if check.Check == nil {
+		err = fmt.Errorf("health check %s has no check function", check.Name)
+	} else {
+		err = runWithContext(checkCtx, func() error {
+			return check.Check(checkCtx)
+		})
+	}
+	result.Duration = time.Since(start)
+
+	if err != nil {
+		result.Status = HealthDown
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// healthCheckTimeout đọc timeout mặc định của health checks từ config "app.health.timeout".
+//
+// Trả về:
+//   - time.Duration: Timeout mặc định, 5s nếu chưa cấu hình hoặc không hợp lệ
+func (a *application) healthCheckTimeout() time.Duration {
+	cfg, ok := a.configManager()
+	if !ok {
+		return defaultHealthCheckTimeout
+	}
+	value, ok := cfg.GetString("app.health.timeout")
+	if !ok || value == "" {
+		return defaultHealthCheckTimeout
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		return defaultHealthCheckTimeout
+	}
+	return timeout
+}
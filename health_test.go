@@ -0,0 +1,194 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+	"go.fork.vn/di"
+)
+
+// TestApplication_Health tests health check aggregation
+func TestApplication_Health(t *testing.T) {
+	t.Run("not_ready_before_boot", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		provider := newLifecycleProvider("service")
+		provider.checks = []core.HealthCheck{{
+			Name: "database",
+			Kind: core.ReadinessCheck,
+			Check: func(ctx context.Context) error {
+				t.Error("checks must not run before boot")
+				return nil
+			},
+		}}
+		app.Register(provider)
+
+		report := app.Health(context.Background())
+		assert.True(t, report.Live)
+		assert.False(t, report.Ready)
+		assert.Equal(t, core.HealthDown, report.Status)
+		assert.Equal(t, "created", report.State)
+		assert.Empty(t, report.Checks)
+	})
+
+	t.Run("ready_when_all_checks_pass", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		provider := newLifecycleProvider("service")
+		provider.checks = []core.HealthCheck{
+			{Name: "ping", Kind: core.LivenessCheck, Check: func(ctx context.Context) error { return nil }},
+			{Name: "database", Kind: core.ReadinessCheck, Check: func(ctx context.Context) error { return nil }},
+		}
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		report := app.Health(context.Background())
+		assert.True(t, report.Live)
+		assert.True(t, report.Ready)
+		assert.Equal(t, core.HealthUp, report.Status)
+		require.Len(t, report.Checks, 2)
+		assert.Equal(t, "ping", report.Checks[0].Name)
+		assert.Equal(t, "*core_test.lifecycleProvider", report.Checks[0].Provider)
+		assert.Equal(t, core.HealthUp, report.Checks[1].Status)
+	})
+
+	t.Run("failing_readiness_check_marks_not_ready", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		provider := newLifecycleProvider("service")
+		provider.checks = []core.HealthCheck{{
+			Name:  "database",
+			Kind:  core.ReadinessCheck,
+			Check: func(ctx context.Context) error { return errors.New("connection refused") },
+		}}
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		report := app.Health(context.Background())
+		assert.True(t, report.Live)
+		assert.False(t, report.Ready)
+		assert.Equal(t, core.HealthDown, report.Checks[0].Status)
+		assert.Equal(t, "connection refused", report.Checks[0].Error)
+	})
+
+	t.Run("failing_liveness_check_marks_not_live", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		provider := newLifecycleProvider("service")
+		provider.checks = []core.HealthCheck{{
+			Name:  "deadlock",
+			Kind:  core.LivenessCheck,
+			Check: func(ctx context.Context) error { return errors.New("worker stuck") },
+		}}
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		report := app.Health(context.Background())
+		assert.False(t, report.Live)
+		assert.True(t, report.Ready)
+		assert.Equal(t, core.HealthDown, report.Status)
+	})
+
+	t.Run("applies_check_timeout", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		provider := newLifecycleProvider("service")
+		provider.checks = []core.HealthCheck{{
+			Name:    "slow",
+			Timeout: 10 * time.Millisecond,
+			Check: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		}}
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		report := app.Health(context.Background())
+		assert.False(t, report.Ready)
+		require.Len(t, report.Checks, 1)
+		assert.Equal(t, core.ReadinessCheck, report.Checks[0].Kind)
+		assert.Contains(t, report.Checks[0].Error, "deadline exceeded")
+	})
+
+	t.Run("applies_default_timeout_from_config", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{
+			"app.health.timeout": "10ms",
+		})
+
+		provider := newLifecycleProvider("service")
+		provider.checks = []core.HealthCheck{{
+			Name: "slow",
+			Check: func(ctx context.Context) error {
+				deadline, ok := ctx.Deadline()
+				assert.True(t, ok)
+				assert.WithinDuration(t, time.Now().Add(10*time.Millisecond), deadline, 10*time.Millisecond)
+				return nil
+			},
+		}}
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		assert.True(t, app.Health(context.Background()).Ready)
+	})
+
+	t.Run("recovers_panicking_check", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		provider := newLifecycleProvider("service")
+		provider.checks = []core.HealthCheck{
+			{Name: "panics", Check: func(ctx context.Context) error { panic("boom") }},
+			{Name: "missing"},
+		}
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		report := app.Health(context.Background())
+		require.Len(t, report.Checks, 2)
+		assert.Contains(t, report.Checks[0].Error, "boom")
+		assert.Contains(t, report.Checks[1].Error, "no check function")
+	})
+
+	t.Run("not_ready_during_and_after_shutdown", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		var duringShutdown *core.HealthReport
+		provider := newLifecycleProvider("service")
+		provider.shutdown = func(ctx context.Context, _ di.Application) error {
+			duringShutdown = app.Health(ctx)
+			return nil
+		}
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+		require.NoError(t, app.Shutdown(context.Background()))
+
+		require.NotNil(t, duringShutdown)
+		assert.False(t, duringShutdown.Ready)
+		assert.Equal(t, "shutting_down", duringShutdown.State)
+
+		after := app.Health(context.Background())
+		assert.False(t, after.Ready)
+		assert.False(t, after.Live)
+	})
+}
@@ -0,0 +1,202 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// SpanRecord là một span đã kết thúc được JSONTracer ghi ra.
+type SpanRecord struct {
+	// TraceID là ID của trace chứa span (32 ký tự hex)
+	TraceID string `json:"trace_id"`
+	// SpanID là ID của span (16 ký tự hex)
+	SpanID string `json:"span_id"`
+	// ParentID là ID của span cha, rỗng nếu là span gốc
+	ParentID string `json:"parent_id,omitempty"`
+	// Name là tên span
+	Name string `json:"name"`
+	// Start là thời điểm bắt đầu span
+	Start time.Time `json:"start"`
+	// Duration là thời gian chạy của span
+	Duration time.Duration `json:"duration_ns"`
+	// Attributes là các attributes của span
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	// Error là message lỗi, rỗng nếu thao tác thành công
+	Error string `json:"error,omitempty"`
+}
+
+// JSONTracer là Tracer ghi mỗi span đã kết thúc thành một dòng JSON (JSON Lines).
+//
+// Spans được ghi khi End() được gọi, nên span con xuất hiện trước span cha.
+// Output có thể được đọc offline mà không cần collector bên ngoài.
+type JSONTracer struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+	err    error
+}
+
+// NewJSONTracer tạo JSONTracer ghi spans vào writer.
+//
+// Tham số:
+//   - w: io.Writer - Writer đích, ví dụ os.Stdout
+//
+// Trả về:
+//   - *JSONTracer: Tracer instance
+//
+// Ví dụ:
+//
+//	app.SetTracer(core.NewJSONTracer(os.Stdout))
+func NewJSONTracer(w io.Writer) *JSONTracer {
+	return &JSONTracer{w: w}
+}
+
+// NewFileTracer tạo JSONTracer ghi spans vào file, tạo mới hoặc ghi đè file đã có.
+//
+// Tham số:
+//   - path: string - Đường dẫn file trace
+//
+// Trả về:
+//   - *JSONTracer: Tracer instance, gọi Close() để đóng file
+//   - error: Lỗi nếu không tạo được file
+func NewFileTracer(path string) (*JSONTracer, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace file: %w", err)
+	}
+	return &JSONTracer{w: file, closer: file}, nil
+}
+
+// Start bắt đầu span mới.
+//
+// Implement Tracer interface method. Span là con của JSONTracer span trong ctx
+// (nếu có) và dùng chung trace ID với span cha.
+func (t *JSONTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	record := SpanRecord{
+		SpanID: newTraceID(8),
+		Name:   name,
+		Start:  time.Now(),
+	}
+	if parent, ok := ctx.Value(jsonSpanKey{}).(*jsonSpan); ok {
+		record.TraceID = parent.record.TraceID
+		record.ParentID = parent.record.SpanID
+	} else {
+		record.TraceID = newTraceID(16)
+	}
+
+	span := &jsonSpan{tracer: t, record: record}
+	return context.WithValue(ctx, jsonSpanKey{}, span), span
+}
+
+// Err trả về lỗi ghi đầu tiên, nếu có.
+//
+// Trả về:
+//   - error: Lỗi ghi spans, nil nếu mọi span được ghi thành công
+func (t *JSONTracer) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+// Close đóng file trace nếu tracer được tạo bởi NewFileTracer.
+//
+// Trả về:
+//   - error: Lỗi đóng file
+func (t *JSONTracer) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closer == nil {
+		return nil
+	}
+	closer := t.closer
+	t.closer = nil
+	return closer.Close()
+}
+
+// write ghi span record thành một dòng JSON.
+func (t *JSONTracer) write(record SpanRecord) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		// Attributes không encode được: ghi lại dạng chuỗi
+		for key, value := range record.Attributes {
+			record.Attributes[key] = fmt.Sprint(value)
+		}
+		data, err = json.Marshal(record)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err == nil {
+		_, err = t.w.Write(append(data, '\n'))
+	}
+	if err != nil && t.err == nil {
+		t.err = err
+	}
+}
+
+// jsonSpanKey là context key của span hiện tại của JSONTracer.
+type jsonSpanKey struct{}
+
+// jsonSpan là Span của JSONTracer.
+type jsonSpan struct {
+	tracer *JSONTracer
+	mu     sync.Mutex
+	ended  bool
+	record SpanRecord
+}
+
+// SetAttribute implement Span interface method.
+func (s *jsonSpan) SetAttribute(key string, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ended {
+		return
+	}
+	if s.record.Attributes == nil {
+		s.record.Attributes = make(map[string]interface{})
+	}
+	s.record.Attributes[key] = value
+}
+
+// End implement Span interface method.
+func (s *jsonSpan) End(err error) {
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.record.Duration = time.Since(s.record.Start)
+	if err != nil {
+		s.record.Error = err.Error()
+	}
+	record := s.record
+	s.mu.Unlock()
+
+	s.tracer.write(record)
+}
+
+// newTraceID tạo ID ngẫu nhiên dạng hex.
+//
+// Tham số:
+//   - size: int - Số bytes ngẫu nhiên
+//
+// Trả về:
+//   - string: ID gồm 2*size ký tự hex
+func newTraceID(size int) string {
+	id := make([]byte, size)
+	if _, err := rand.Read(id); err != nil {
+		now := time.Now().UnixNano()
+		for i := range id {
+			id[i] = byte(now >> (8 * (i % 8)))
+		}
+	}
+	return hex.EncodeToString(id)
+}
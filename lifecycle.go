@@ -0,0 +1,184 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.fork.vn/di"
+)
+
+// State đại diện cho trạng thái lifecycle của application.
+type State int32
+
+const (
+	// StateCreated là trạng thái ban đầu, providers chưa được boot
+	StateCreated State = iota
+	// StateBooting là trạng thái đang boot providers
+	StateBooting
+	// StateBooted là trạng thái tất cả providers đã boot thành công
+	StateBooted
+	// StateFailed là trạng thái boot providers thất bại
+	StateFailed
+	// StateShuttingDown là trạng thái đang shutdown providers
+	StateShuttingDown
+	// StateShutdown là trạng thái đã shutdown xong
+	StateShutdown
+)
+
+// String trả về tên của state.
+//
+// Trả về:
+//   - string: Tên state, ví dụ "booted"
+func (s State) String() string {
+	switch s {
+	case StateCreated:
+		return "created"
+	case StateBooting:
+		return "booting"
+	case StateBooted:
+		return "booted"
+	case StateFailed:
+		return "failed"
+	case StateShuttingDown:
+		return "shutting_down"
+	case StateShutdown:
+		return "shutdown"
+	default:
+		return fmt.Sprintf("state(%d)", int32(s))
+	}
+}
+
+// ShutdownProvider là interface tùy chọn cho service provider cần giải phóng resource.
+//
+// Provider implement interface này sẽ được gọi Shutdown khi application shutdown,
+// theo thứ tự ngược với thứ tự boot để dependents được shutdown trước dependencies.
+type ShutdownProvider interface {
+	// Shutdown giải phóng các resource mà provider đã khởi tạo khi boot.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context shutdown, bị hủy khi hết thời gian cho phép
+	//   - app: di.Application - Application instance
+	//
+	// Trả về:
+	//   - error: Lỗi nếu shutdown thất bại
+	Shutdown(ctx context.Context, app di.Application) error
+}
+
+// ProviderShutdownError represent lỗi trả về từ quá trình shutdown của một provider.
+type ProviderShutdownError struct {
+	// Provider là tên (type) của provider shutdown thất bại
+	Provider string
+	// Err là lỗi gốc
+	Err error
+}
+
+// Error implement error interface.
+//
+// Trả về:
+//   - string: Error message với tên provider và lỗi gốc
+func (e *ProviderShutdownError) Error() string {
+	return fmt.Sprintf("failed to shutdown service provider %s: %v", e.Provider, e.Err)
+}
+
+// Unwrap trả về lỗi gốc để hỗ trợ errors.Is và errors.As.
+func (e *ProviderShutdownError) Unwrap() error {
+	return e.Err
+}
+
+// State trả về trạng thái lifecycle hiện tại của application.
+//
+// Implement Application interface method.
+//
+// Trả về:
+//   - State: Trạng thái hiện tại
+func (a *application) State() State {
+	return State(a.state.Load())
+}
+
+// setState cập nhật trạng thái lifecycle.
+//
+// Tham số:
+//   - state: State - Trạng thái mới
+func (a *application) setState(state State) {
+	a.state.Store(int32(state))
+	a.recordState(state)
+}
+
+// Shutdown shutdown tất cả service providers đã boot.
+//
+// Implement Application interface method.
+//
+// Providers implement ShutdownProvider được gọi theo thứ tự ngược với thứ tự
+// boot. Lỗi của từng provider được gom lại và trả về cùng lúc; một provider
+// lỗi không ngăn các providers còn lại được shutdown. Chỉ lần gọi đầu tiên
+// shutdown providers; các lần gọi khác (kể cả đồng thời) chờ lần đầu kết thúc
+// và trả về cùng kết quả, hoặc ctx.Err() nếu ctx của chúng bị hủy trước.
+//
+// Tham số:
+//   - ctx: context.Context - Context giới hạn thời gian shutdown
+//
+// Trả về:
+//   - error: Lỗi gộp của các providers shutdown thất bại
+func (a *application) Shutdown(ctx context.Context) error {
+	// Chỉ goroutine chuyển state sang StateShuttingDown thành công được shutdown providers
+	for {
+		current := a.state.Load()
+		switch State(current) {
+		case StateShuttingDown, StateShutdown:
+			select {
+			case <-a.shutdownDone:
+				return a.shutdownErr
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if a.state.CompareAndSwap(current, int32(StateShuttingDown)) {
+			break
+		}
+	}
+	a.recordState(StateShuttingDown)
+	defer close(a.shutdownDone)
+
+	providers := a.bootOrder()
+
+	var errs []error
+	for i := len(providers) - 1; i >= 0; i-- {
+		provider := providers[i]
+		shutdowner, ok := provider.(ShutdownProvider)
+		if !ok || !a.recorder.isBooted(provider) {
+			continue
+		}
+
+		if err := a.shutdownProvider(ctx, provider, shutdowner); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	a.shutdownErr = errors.Join(errs...)
+	a.setState(StateShutdown)
+	return a.shutdownErr
+}
+
+// shutdownProvider gọi Shutdown của một provider với context.
+//
+// Tham số:
+//   - ctx: context.Context - Context shutdown
+//   - provider: di.ServiceProvider - Provider cần shutdown
+//   - shutdowner: ShutdownProvider - Provider dưới dạng ShutdownProvider
+//
+// Trả về:
+//   - error: ProviderShutdownError nếu shutdown thất bại
+func (a *application) shutdownProvider(ctx context.Context, provider di.ServiceProvider, shutdowner ShutdownProvider) error {
+	if err := ctx.Err(); err != nil {
+		return &ProviderShutdownError{Provider: providerName(provider), Err: err}
+	}
+
+	err := runWithContext(ctx, func() error {
+		return shutdowner.Shutdown(ctx, a)
+	})
+	if err != nil {
+		return &ProviderShutdownError{Provider: providerName(provider), Err: err}
+	}
+	return nil
+}
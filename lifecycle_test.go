@@ -0,0 +1,297 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+	"go.fork.vn/di"
+)
+
+// lifecycleProvider là service provider test implement core.ShutdownProvider và core.HealthChecker.
+type lifecycleProvider struct {
+	contextProvider
+	checks   []core.HealthCheck
+	shutdown func(ctx context.Context, app di.Application) error
+}
+
+func (p *lifecycleProvider) HealthChecks() []core.HealthCheck {
+	return p.checks
+}
+
+func (p *lifecycleProvider) Shutdown(ctx context.Context, app di.Application) error {
+	if p.shutdown == nil {
+		return nil
+	}
+	return p.shutdown(ctx, app)
+}
+
+// newLifecycleProvider tạo lifecycleProvider boot thành công.
+func newLifecycleProvider(provides string, requires ...string) *lifecycleProvider {
+	return &lifecycleProvider{
+		contextProvider: contextProvider{
+			provides: []string{provides},
+			requires: requires,
+			boot: func(ctx context.Context, app di.Application) error {
+				return nil
+			},
+		},
+	}
+}
+
+// TestApplication_State tests lifecycle state transitions
+func TestApplication_State(t *testing.T) {
+	t.Run("transitions_from_created_to_booted", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		assert.Equal(t, core.StateCreated, app.State())
+
+		var bootingState core.State
+		provider := newLifecycleProvider("service")
+		provider.boot = func(ctx context.Context, _ di.Application) error {
+			bootingState = app.State()
+			return nil
+		}
+		app.Register(provider)
+
+		require.NoError(t, app.Boot())
+		assert.Equal(t, core.StateBooting, bootingState)
+		assert.Equal(t, core.StateBooted, app.State())
+	})
+
+	t.Run("marks_failed_when_boot_fails", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		provider := newLifecycleProvider("service")
+		provider.boot = func(ctx context.Context, app di.Application) error {
+			return errors.New("boot failed")
+		}
+		app.Register(provider)
+
+		assert.Error(t, app.Boot())
+		assert.Equal(t, core.StateFailed, app.State())
+	})
+
+	t.Run("state_names", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "created", core.StateCreated.String())
+		assert.Equal(t, "booting", core.StateBooting.String())
+		assert.Equal(t, "booted", core.StateBooted.String())
+		assert.Equal(t, "failed", core.StateFailed.String())
+		assert.Equal(t, "shutting_down", core.StateShuttingDown.String())
+		assert.Equal(t, "shutdown", core.StateShutdown.String())
+		assert.Equal(t, "state(42)", core.State(42).String())
+	})
+}
+
+// TestApplication_Shutdown tests provider shutdown
+func TestApplication_Shutdown(t *testing.T) {
+	t.Run("shuts_down_in_reverse_boot_order", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		var order []string
+		providerA := newLifecycleProvider("service.a")
+		providerA.shutdown = func(ctx context.Context, app di.Application) error {
+			order = append(order, "A")
+			return nil
+		}
+		providerB := newLifecycleProvider("service.b", "service.a")
+		providerB.shutdown = func(ctx context.Context, app di.Application) error {
+			order = append(order, "B")
+			return nil
+		}
+
+		app.Register(providerB)
+		app.Register(providerA)
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Shutdown(context.Background()))
+		assert.Equal(t, []string{"B", "A"}, order)
+		assert.Equal(t, core.StateShutdown, app.State())
+	})
+
+	t.Run("continues_after_provider_error_and_joins_errors", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		shutdownErr := errors.New("close failed")
+
+		var aShutdown bool
+		providerA := newLifecycleProvider("service.a")
+		providerA.shutdown = func(ctx context.Context, app di.Application) error {
+			aShutdown = true
+			return nil
+		}
+		providerB := newLifecycleProvider("service.b", "service.a")
+		providerB.shutdown = func(ctx context.Context, app di.Application) error {
+			return shutdownErr
+		}
+
+		app.Register(providerA)
+		app.Register(providerB)
+		require.NoError(t, app.Boot())
+
+		err := app.Shutdown(context.Background())
+		assert.ErrorIs(t, err, shutdownErr)
+
+		var providerErr *core.ProviderShutdownError
+		require.True(t, errors.As(err, &providerErr))
+		assert.Equal(t, "*core_test.lifecycleProvider", providerErr.Provider)
+		assert.True(t, aShutdown)
+	})
+
+	t.Run("skips_providers_that_were_not_booted", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		providerA := newLifecycleProvider("service.a")
+		providerA.boot = func(ctx context.Context, app di.Application) error {
+			return errors.New("boot failed")
+		}
+		providerA.shutdown = func(ctx context.Context, app di.Application) error {
+			t.Error("provider that failed to boot must not be shut down")
+			return nil
+		}
+
+		app.Register(providerA)
+		require.Error(t, app.Boot())
+
+		assert.NoError(t, app.Shutdown(context.Background()))
+	})
+
+	t.Run("is_idempotent", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		calls := 0
+		provider := newLifecycleProvider("service")
+		provider.shutdown = func(ctx context.Context, app di.Application) error {
+			calls++
+			return nil
+		}
+
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Shutdown(context.Background()))
+		assert.NoError(t, app.Shutdown(context.Background()))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("shuts_down_once_when_called_concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		var calls atomic.Int32
+		provider := newLifecycleProvider("service")
+		provider.shutdown = func(ctx context.Context, app di.Application) error {
+			calls.Add(1)
+			return nil
+		}
+
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, app.Shutdown(context.Background()))
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("concurrent_callers_wait_for_same_result", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		provider := newLifecycleProvider("service")
+		provider.shutdown = func(ctx context.Context, app di.Application) error {
+			close(started)
+			<-release
+			return assert.AnError
+		}
+
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		first := make(chan error, 1)
+		go func() { first <- app.Shutdown(context.Background()) }()
+		<-started
+
+		second := make(chan error, 1)
+		go func() { second <- app.Shutdown(context.Background()) }()
+		select {
+		case err := <-second:
+			t.Fatalf("second Shutdown returned %v before providers were shut down", err)
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		close(release)
+		assert.ErrorIs(t, <-first, assert.AnError)
+		assert.ErrorIs(t, <-second, assert.AnError)
+		assert.ErrorIs(t, app.Shutdown(context.Background()), assert.AnError)
+	})
+
+	t.Run("waiting_caller_honours_its_context", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		defer close(release)
+		provider := newLifecycleProvider("service")
+		provider.shutdown = func(ctx context.Context, app di.Application) error {
+			close(started)
+			<-release
+			return nil
+		}
+
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		go func() { _ = app.Shutdown(context.Background()) }()
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, app.Shutdown(ctx), context.DeadlineExceeded)
+	})
+
+	t.Run("reports_cancelled_context", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		provider := newLifecycleProvider("service")
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := app.Shutdown(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -1,15 +1,63 @@
 package core
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"go.fork.vn/config"
 	"go.fork.vn/di"
 	"go.fork.vn/log"
 )
 
+// ModuleLoaderContract định nghĩa interface cho module loader của Fork application,
+// extends di.ModuleLoaderContract với các phương thức bootstrap nâng cao.
 type ModuleLoaderContract interface {
 	di.ModuleLoaderContract
+
+	// BootstrapApplicationContext khởi tạo application với context.
+	//
+	// Workflow giống BootstrapApplication() nhưng dừng lại khi context bị hủy
+	// và truyền context xuống quá trình boot của từng provider.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context điều khiển quá trình bootstrap
+	//
+	// Trả về:
+	//   - error: Lỗi nếu bất kỳ bước nào thất bại hoặc context bị hủy
+	BootstrapApplicationContext(ctx context.Context) error
+
+	// UnloadModule gỡ một module/provider đã load khỏi application.
+	//
+	// Tham số:
+	//   - module: interface{} - Module cần gỡ (phải là di.ServiceProvider)
+	//
+	// Trả về:
+	//   - error: *ProviderInUseError nếu providers khác còn phụ thuộc module
+	UnloadModule(module interface{}) error
+
+	// ReplaceModule thay một module đã load bằng module mới, rollback nếu module mới boot thất bại.
+	//
+	// Tham số:
+	//   - old: interface{} - Module đang được sử dụng (phải là di.ServiceProvider)
+	//   - replacement: interface{} - Module thay thế (phải là di.ServiceProvider)
+	//
+	// Trả về:
+	//   - error: Lỗi nếu module không hợp lệ, thiếu dependencies, boot hoặc teardown thất bại
+	ReplaceModule(old, replacement interface{}) error
+
+	// LoadPlugin load một module từ Go plugin shared object (.so).
+	//
+	// Plugin phải export symbol PluginSymbol trả về di.ServiceProvider. Provider
+	// được load qua LoadModule() nên manifest và dependencies được kiểm tra.
+	//
+	// Tham số:
+	//   - path: string - Đường dẫn tới file .so
+	//
+	// Trả về:
+	//   - error: *PluginLoadError, bọc ErrPluginsNotSupported trên platforms không hỗ trợ
+	LoadPlugin(path string) error
 }
 
 // moduleLoader implement di.ModuleLoaderContract để quản lý việc load và bootstrap modules.
@@ -40,30 +88,157 @@ func newModuleLoader(app Application) ModuleLoaderContract {
 //
 // Implement di.ModuleLoaderContract interface method.
 //
+// Mỗi bước được log qua Log() dưới dạng lifecycle events có cấu trúc
+// ("lifecycle event=<name> key=value ..."): nguồn config, environment,
+// register/boot từng provider kèm duration, providers bị bỏ qua và lỗi.
+// Events phát sinh trước khi log manager sẵn sàng được giữ lại và log sau.
+//
 // Workflow:
 //  1. Đăng ký core service providers (config, log)
-//  2. Đăng ký tất cả service providers đã add
-//  3. Boot tất cả service providers
+//  2. Load các Go plugins khai báo trong config "app.modules.plugins"
+//  3. Kiểm tra tương thích manifests của các modules
+//  4. Đăng ký tất cả service providers đã add
+//  5. Boot tất cả service providers
 //
 // Trả về:
-//   - error: Lỗi nếu bất kỳ bước nào thất bại
+//   - error: Lỗi nếu bất kỳ bước nào thất bại, *ModuleCompatibilityError nếu modules không tương thích
 func (l *moduleLoader) BootstrapApplication() error {
-	// Step 1: Register core providers
-	if err := l.RegisterCoreProviders(); err != nil {
+	return l.BootstrapApplicationContext(context.Background())
+}
+
+// BootstrapApplicationContext khởi tạo application với context.
+//
+// Implement ModuleLoaderContract interface method.
+//
+// Tham số:
+//   - ctx: context.Context - Context điều khiển quá trình bootstrap
+//
+// Trả về:
+//   - error: Lỗi nếu bất kỳ bước nào thất bại hoặc context bị hủy
+func (l *moduleLoader) BootstrapApplicationContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
 		return err
 	}
 
-	// Step 2: Register ALL providers với dependency checking
-	if err := l.app.RegisterWithDependencies(); err != nil {
+	start := time.Now()
+	l.logEvent("info", "bootstrap.start")
+	ctx, span := startSpan(l.app.Tracer(), ctx, SpanBootstrap)
+	err := l.bootstrap(ctx)
+	span.End(err)
+	if err != nil {
+		l.logEvent("error", "bootstrap.failed", "duration", time.Since(start), "error", err)
+	} else {
+		l.logEvent("info", "bootstrap.end", "duration", time.Since(start))
+	}
+	l.flushEvents()
+	return err
+}
+
+// bootstrap chạy lần lượt các bước của BootstrapApplicationContext.
+//
+// Tham số:
+//   - ctx: context.Context - Context chứa span bootstrap
+//
+// Trả về:
+//   - error: Lỗi của bước đầu tiên thất bại
+func (l *moduleLoader) bootstrap(ctx context.Context) error {
+	// Step 1-4: Register core providers, plugins và tất cả providers
+	if err := registerApplication(ctx, l.app); err != nil {
 		return err
 	}
 
-	// Step 3: Boot all providers
-	if err := l.app.BootServiceProviders(); err != nil {
+	// Step 5: Boot all providers
+	return l.app.BootServiceProvidersContext(ctx)
+}
+
+// registerApplication chuẩn bị providers của application trước khi boot.
+//
+// Dùng chung cho BootstrapApplicationContext và console Kernel để mọi đường
+// khởi động đăng ký cùng một tập providers. Spans của config và Register là
+// con của span trong ctx khi application và loader hỗ trợ nhận context.
+//
+// Tham số:
+//   - ctx: context.Context - Context chứa span cha
+//   - app: Application - Application cần chuẩn bị
+//
+// Trả về:
+//   - error: Lỗi của bước đầu tiên thất bại
+func registerApplication(ctx context.Context, app Application) error {
+	// Step 1-3: Core providers, plugins và kiểm tra module manifests
+	if err := prepareApplication(ctx, app); err != nil {
 		return err
 	}
 
-	return nil
+	// Step 4: Register ALL providers với dependency checking
+	if registrar, ok := app.(contextRegistrar); ok {
+		return registrar.registerWithDependencies(ctx)
+	}
+	return app.RegisterWithDependencies()
+}
+
+// prepareApplication thực hiện các bước của registerApplication trước khi
+// gọi Register của providers: đăng ký core providers, load plugins từ config
+// và kiểm tra module manifests.
+//
+// Diagnostic commands dùng riêng bước này để thấy cùng tập providers như
+// BootstrapApplication() mà không chạy Register của providers.
+//
+// Tham số:
+//   - ctx: context.Context - Context chứa span cha
+//   - app: Application - Application cần chuẩn bị
+//
+// Trả về:
+//   - error: Lỗi của bước đầu tiên thất bại
+func prepareApplication(ctx context.Context, app Application) error {
+	// Step 1: Register core providers
+	var err error
+	if loader, ok := app.ModuleLoader().(contextCoreRegistrar); ok {
+		err = loader.registerCoreProviders(ctx)
+	} else {
+		err = app.ModuleLoader().RegisterCoreProviders()
+	}
+	if err != nil {
+		return err
+	}
+
+	// Step 2: Load plugin modules từ config
+	if err := loadConfiguredPlugins(app); err != nil {
+		return err
+	}
+
+	// Step 3: Check module manifests trước khi register
+	return checkModules(app.ServiceProviders(), nil, true)
+}
+
+// contextCoreRegistrar là interface tùy chọn cho module loader đăng ký core
+// providers với context chứa span cha.
+type contextCoreRegistrar interface {
+	registerCoreProviders(ctx context.Context) error
+}
+
+// contextRegistrar là interface tùy chọn cho application đăng ký providers
+// với context chứa span cha.
+type contextRegistrar interface {
+	registerWithDependencies(ctx context.Context) error
+}
+
+// logEvent log lifecycle event qua application nếu application hỗ trợ.
+//
+// Tham số:
+//   - level: string - Mức log
+//   - name: string - Tên event
+//   - fields: ...interface{} - Các cặp key, value mô tả event
+func (l *moduleLoader) logEvent(level, name string, fields ...interface{}) {
+	if app, ok := l.app.(*application); ok {
+		app.logEvent(level, name, fields...)
+	}
+}
+
+// flushEvents log các lifecycle events còn được giữ lại nếu log manager resolve được.
+func (l *moduleLoader) flushEvents() {
+	if app, ok := l.app.(*application); ok {
+		app.flushEvents(true)
+	}
 }
 
 // RegisterCoreProviders đăng ký các core service providers cần thiết.
@@ -77,6 +252,17 @@ func (l *moduleLoader) BootstrapApplication() error {
 // Trả về:
 //   - error: Lỗi nếu đăng ký core providers thất bại
 func (l *moduleLoader) RegisterCoreProviders() error {
+	return l.registerCoreProviders(context.Background())
+}
+
+// registerCoreProviders đăng ký core service providers với context.
+//
+// Tham số:
+//   - ctx: context.Context - Context chứa span cha của span config
+//
+// Trả về:
+//   - error: Lỗi như RegisterCoreProviders()
+func (l *moduleLoader) registerCoreProviders(ctx context.Context) error {
 	// 1. Register config provider vào list
 	configProvider := config.NewServiceProvider()
 	// l.app.Register(configProvider)
@@ -85,7 +271,10 @@ func (l *moduleLoader) RegisterCoreProviders() error {
 	configProvider.Register(l.app)
 
 	// 3. Apply config sau khi config provider đã register
-	if err := l.applyConfig(); err != nil {
+	_, span := startSpan(l.app.Tracer(), ctx, SpanConfig)
+	err := l.applyConfig(span)
+	span.End(err)
+	if err != nil {
 		return err
 	}
 
@@ -95,7 +284,14 @@ func (l *moduleLoader) RegisterCoreProviders() error {
 	return nil
 }
 
-func (l *moduleLoader) applyConfig() error {
+// applyConfig nạp config theo app.config vào config manager.
+//
+// Tham số:
+//   - span: Span - Span của việc nạp config, được gắn attribute "source"
+//
+// Trả về:
+//   - error: Lỗi nếu app.config không hợp lệ hoặc đọc config thất bại
+func (l *moduleLoader) applyConfig(span Span) error {
 	// Lấy config từ DI container với safe type assertion
 	configInterface, err := l.app.Container().Make("app.config")
 	if err != nil {
@@ -118,30 +314,63 @@ func (l *moduleLoader) applyConfig() error {
 		return fmt.Errorf("invalid config manager type: expected config.Manager, got %T", configManagerInterface)
 	}
 
-	// Apply config settings safely
-	if file, ok := cfg["file"].(string); ok {
+	// Inline config (settings, data, fs) được nạp trực tiếp, không đọc filesystem
+	inline, err := readInlineConfig(configManager, cfg)
+	if err != nil {
+		l.logEvent("error", "config.failed", "source", inlineSource(cfg), "error", err)
+		return err
+	}
+
+	if inline {
+		span.SetAttribute("source", inlineSource(cfg))
+		l.logEvent("info", "config.source", "source", inlineSource(cfg))
+	} else if file, ok := cfg["file"].(string); ok {
+		span.SetAttribute("source", "file")
+		span.SetAttribute("file", file)
 		configManager.SetConfigFile(file)
 		// Read config with error handling
 		if err := configManager.ReadInConfig(); err != nil {
+			l.logEvent("error", "config.failed", "source", "file", "file", file, "error", err)
 			return fmt.Errorf("config read failed: %w", err)
 		}
-		return nil
+		l.logEvent("info", "config.source", "source", "file", "file", file)
 	} else {
-		if name, ok := cfg["name"].(string); ok {
+		name, hasName := cfg["name"].(string)
+		if hasName {
 			configManager.SetConfigName(name)
 		}
-		if path, ok := cfg["path"].(string); ok {
+		path, hasPath := cfg["path"].(string)
+		if hasPath {
 			configManager.AddConfigPath(path)
 		}
 		if fileType, ok := cfg["type"].(string); ok {
 			configManager.SetConfigType(fileType)
 		}
+		span.SetAttribute("source", "search")
 		// Read config with error handling
 		if err := configManager.ReadInConfig(); err != nil {
+			l.logEvent("error", "config.failed", "source", "search", "name", name, "path", path, "error", err)
 			return fmt.Errorf("config read failed: %w", err)
 		}
+		l.logEvent("info", "config.source", "source", "search", "name", name, "path", path)
 	}
 
+	// Overrides từ app.config (ví dụ từ global flags --env, --debug của console kernel)
+	if env, ok := cfg["environment"].(string); ok && env != "" {
+		configManager.Set("app.environment", env)
+	}
+	if debug, ok := cfg["debug"].(bool); ok {
+		configManager.Set("app.debug", debug)
+	}
+
+	if audit, ok := configManager.GetBool("app.audit.enabled"); ok && audit {
+		l.app.EnableAudit()
+	}
+
+	environment, _ := configManager.GetString("app.environment")
+	debug, _ := configManager.GetBool("app.debug")
+	l.logEvent("info", "config.environment", "environment", environment, "debug", debug)
+
 	return nil
 }
 
@@ -151,33 +380,37 @@ func (l *moduleLoader) applyConfig() error {
 //
 // Phương thức này:
 //  1. Kiểm tra module có phải là ServiceProvider không
-//  2. Đăng ký module vào application
-//  3. Boot module nếu application đã được booted
+//  2. Nếu application chưa boot, kiểm tra manifest của module (tên, version,
+//     MinCoreVersion) rồi đăng ký module để boot cùng các providers khác;
+//     Requires được kiểm tra khi BootstrapApplication()
+//  3. Nếu application đã boot, kiểm tra dependencies và manifest với graph hiện
+//     tại rồi register và boot module ngay qua Application.LoadProviders()
 //
 // Tham số:
 //   - module: interface{} - Module cần load (phải là di.ServiceProvider)
 //
 // Trả về:
-//   - error: Lỗi nếu module không hợp lệ hoặc load thất bại
+//   - error: ModuleLoadError nếu module không hợp lệ, không tương thích, thiếu
+//     dependencies hoặc boot thất bại; lỗi gốc truy cập được qua errors.Is/errors.As
 func (l *moduleLoader) LoadModule(module interface{}) error {
 	// Kiểm tra module có phải ServiceProvider không
 	provider, ok := module.(di.ServiceProvider)
 	if !ok {
-		return &ModuleLoadError{
-			Module: module,
-			Reason: "module must implement di.ServiceProvider interface",
-		}
+		return newModuleLoadError(module, "module must implement di.ServiceProvider interface", nil)
 	}
 
-	// Đăng ký provider
-	l.app.Register(provider)
-
-	// Nếu app đã booted, cần register và boot provider mới ngay
+	// Nếu app đã booted, load provider theo dependency graph hiện tại
 	if l.isAppBooted() {
-		provider.Register(l.app)
-		provider.Boot(l.app)
+		if err := l.app.LoadProviders(context.Background(), provider); err != nil {
+			return newModuleLoadError(module, "", err)
+		}
+		return nil
 	}
 
+	if err := l.checkPending(provider); err != nil {
+		return newModuleLoadError(module, "", err)
+	}
+	l.app.Register(provider)
 	return nil
 }
 
@@ -185,33 +418,289 @@ func (l *moduleLoader) LoadModule(module interface{}) error {
 //
 // Implement di.ModuleLoaderContract interface method.
 //
+// Tất cả modules được kiểm tra trước khi load. Khi application đã boot, các
+// modules được load cùng lúc theo dependency order giữa chúng, nên thứ tự
+// truyền vào không quan trọng; batch có circular dependency bị từ chối.
+//
+// Mặc định LoadModules dừng ở module không hợp lệ đầu tiên. Khi config
+// "app.modules.continue_on_error" bật, các modules hợp lệ vẫn được load và
+// lỗi của mọi module thất bại được gộp bằng errors.Join.
+//
 // Tham số:
 //   - modules: ...interface{} - Danh sách modules cần load
 //
 // Trả về:
-//   - error: Lỗi nếu bất kỳ module nào load thất bại
+//   - error: MultiModuleLoadError của module không hợp lệ hoặc module làm batch
+//     thất bại, bọc ModuleLoadError với lỗi gốc; ở continue-on-error mode là lỗi
+//     gộp của tất cả MultiModuleLoadError
 func (l *moduleLoader) LoadModules(modules ...interface{}) error {
+	if l.continueOnError() {
+		return l.loadModulesContinue(modules)
+	}
+
+	providers := make([]di.ServiceProvider, 0, len(modules))
 	for i, module := range modules {
-		if err := l.LoadModule(module); err != nil {
-			return &MultiModuleLoadError{
-				FailedIndex:  i,
-				FailedModule: module,
-				Cause:        err,
-			}
+		provider, ok := module.(di.ServiceProvider)
+		if !ok {
+			return invalidModuleError(i, module)
 		}
+		providers = append(providers, provider)
+	}
+
+	if l.isAppBooted() {
+		if err := l.app.LoadProviders(context.Background(), providers...); err != nil {
+			return batchFailure(modules, err)
+		}
+		return nil
+	}
+
+	if err := l.checkPending(providers...); err != nil {
+		return batchFailure(modules, err)
+	}
+	for _, provider := range providers {
+		l.app.Register(provider)
 	}
 	return nil
 }
 
+// loadModulesContinue load tất cả modules hợp lệ và gộp lỗi của các modules thất bại.
+//
+// Khi application đã boot, modules được load từng cái theo nhiều lượt: module
+// thiếu dependency được thử lại sau khi các modules khác trong batch load xong,
+// cho tới khi không còn module nào load thêm được.
+//
+// Tham số:
+//   - modules: []interface{} - Danh sách modules cần load
+//
+// Trả về:
+//   - error: errors.Join của MultiModuleLoadError theo thứ tự index, nil nếu tất cả thành công
+func (l *moduleLoader) loadModulesContinue(modules []interface{}) error {
+	failures := make(map[int]error)
+	pending := make([]int, 0, len(modules))
+	for i, module := range modules {
+		if _, ok := module.(di.ServiceProvider); !ok {
+			failures[i] = invalidModuleError(i, module)
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	if !l.isAppBooted() {
+		for _, i := range pending {
+			provider := modules[i].(di.ServiceProvider)
+			if err := l.checkPending(provider); err != nil {
+				failures[i] = moduleFailure(i, modules[i], err)
+				continue
+			}
+			l.app.Register(provider)
+		}
+		return joinModuleErrors(failures, len(modules))
+	}
+
+	for progressed := true; progressed && len(pending) > 0; {
+		progressed = false
+		retry := make([]int, 0, len(pending))
+		for _, i := range pending {
+			err := l.app.LoadProviders(context.Background(), modules[i].(di.ServiceProvider))
+			var missingErr *MissingDependenciesError
+			var compatibilityErr *ModuleCompatibilityError
+			switch {
+			case err == nil:
+				progressed = true
+				delete(failures, i)
+			case errors.As(err, &missingErr), errors.As(err, &compatibilityErr):
+				retry = append(retry, i)
+				failures[i] = moduleFailure(i, modules[i], err)
+			default:
+				failures[i] = moduleFailure(i, modules[i], err)
+			}
+		}
+		pending = retry
+	}
+
+	return joinModuleErrors(failures, len(modules))
+}
+
+// checkPending kiểm tra manifests của modules sắp được đăng ký trước khi boot.
+//
+// Requires không được kiểm tra vì modules được require có thể được load sau;
+// BootstrapApplication() kiểm tra đầy đủ trước khi register.
+//
+// Tham số:
+//   - providers: ...di.ServiceProvider - Providers sắp được đăng ký
+//
+// Trả về:
+//   - error: *ModuleCompatibilityError nếu manifest của providers có vấn đề
+func (l *moduleLoader) checkPending(providers ...di.ServiceProvider) error {
+	batch := make(map[string]bool, len(providers))
+	for _, provider := range providers {
+		batch[getProviderKey(provider)] = true
+	}
+	return checkModules(append(l.app.ServiceProviders(), providers...), batch, false)
+}
+
+// continueOnError đọc config "app.modules.continue_on_error".
+//
+// Trả về:
+//   - bool: true nếu LoadModules cần load tiếp khi có module thất bại
+func (l *moduleLoader) continueOnError() bool {
+	cfg, ok := appConfig(l.app)
+	if !ok {
+		return false
+	}
+	enabled, ok := cfg.GetBool("app.modules.continue_on_error")
+	return ok && enabled
+}
+
+// invalidModuleError tạo lỗi cho module không implement di.ServiceProvider.
+//
+// Tham số:
+//   - index: int - Vị trí của module trong danh sách
+//   - module: interface{} - Module không hợp lệ
+//
+// Trả về:
+//   - *MultiModuleLoadError: Lỗi với ModuleLoadError làm nguyên nhân
+func invalidModuleError(index int, module interface{}) *MultiModuleLoadError {
+	return &MultiModuleLoadError{
+		FailedIndex:  index,
+		FailedModule: module,
+		Cause:        newModuleLoadError(module, "module must implement di.ServiceProvider interface", nil),
+	}
+}
+
+// moduleFailure tạo lỗi cho module load thất bại.
+//
+// Tham số:
+//   - index: int - Vị trí của module trong danh sách
+//   - module: interface{} - Module load thất bại
+//   - err: error - Lỗi gốc
+//
+// Trả về:
+//   - *MultiModuleLoadError: Lỗi với ModuleLoadError bọc lỗi gốc
+func moduleFailure(index int, module interface{}, err error) *MultiModuleLoadError {
+	return &MultiModuleLoadError{
+		FailedIndex:  index,
+		FailedModule: module,
+		Cause:        newModuleLoadError(module, "", err),
+	}
+}
+
+// batchFailure tạo lỗi cho batch modules load thất bại.
+//
+// Module thất bại được xác định từ lỗi gốc: provider của conflict manifest,
+// provider thiếu dependency hoặc provider boot thất bại. Lỗi không gắn với một
+// module cụ thể (ví dụ circular dependency) được gán cho module đầu tiên.
+//
+// Tham số:
+//   - modules: []interface{} - Danh sách modules, tất cả là di.ServiceProvider
+//   - err: error - Lỗi load batch
+//
+// Trả về:
+//   - *MultiModuleLoadError: Lỗi với ModuleLoadError bọc lỗi gốc
+func batchFailure(modules []interface{}, err error) *MultiModuleLoadError {
+	matches := func(provider di.ServiceProvider) bool { return false }
+
+	var compatibilityErr *ModuleCompatibilityError
+	var missingErr *MissingDependenciesError
+	var timeoutErr *BootTimeoutError
+	var bootErr *ProviderBootError
+	switch {
+	case errors.As(err, &compatibilityErr) && len(compatibilityErr.Conflicts) > 0:
+		key := compatibilityErr.Conflicts[0].provider
+		matches = func(provider di.ServiceProvider) bool { return getProviderKey(provider) == key }
+	case errors.As(err, &missingErr) && len(missingErr.Missing) > 0:
+		name := missingErr.Missing[0].Provider
+		matches = func(provider di.ServiceProvider) bool { return providerName(provider) == name }
+	case errors.As(err, &timeoutErr):
+		matches = func(provider di.ServiceProvider) bool { return providerName(provider) == timeoutErr.Provider }
+	case errors.As(err, &bootErr):
+		matches = func(provider di.ServiceProvider) bool { return providerName(provider) == bootErr.Provider }
+	}
+
+	for i, module := range modules {
+		if matches(module.(di.ServiceProvider)) {
+			return moduleFailure(i, module, err)
+		}
+	}
+	return moduleFailure(0, modules[0], err)
+}
+
+// joinModuleErrors gộp lỗi của các modules theo thứ tự index.
+//
+// Tham số:
+//   - failures: map[int]error - Lỗi theo index của module
+//   - count: int - Số modules
+//
+// Trả về:
+//   - error: errors.Join của các lỗi, nil nếu không có lỗi
+func joinModuleErrors(failures map[int]error, count int) error {
+	errs := make([]error, 0, len(failures))
+	for i := 0; i < count; i++ {
+		if err, ok := failures[i]; ok {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// UnloadModule gỡ một module/provider đã load khỏi application.
+//
+// Implement ModuleLoaderContract interface method.
+//
+// Phương thức này:
+//  1. Kiểm tra module có phải là ServiceProvider không
+//  2. Từ chối nếu providers còn lại vẫn require services của module
+//  3. Gọi Shutdown của module nếu đã boot, xóa bindings và gỡ module qua
+//     Application.UnloadProvider()
+//
+// Tham số:
+//   - module: interface{} - Module cần gỡ (phải là di.ServiceProvider)
+//
+// Trả về:
+//   - error: ModuleLoadError nếu module không hợp lệ, *ProviderInUseError hoặc lỗi teardown
+func (l *moduleLoader) UnloadModule(module interface{}) error {
+	provider, ok := module.(di.ServiceProvider)
+	if !ok {
+		return newModuleLoadError(module, "module must implement di.ServiceProvider interface", nil)
+	}
+
+	return l.app.UnloadProvider(context.Background(), provider)
+}
+
+// ReplaceModule thay một module đã load bằng module mới.
+//
+// Implement ModuleLoaderContract interface method.
+//
+// Phương thức này:
+//  1. Kiểm tra cả hai modules có phải là ServiceProvider không
+//  2. Kiểm tra dependencies của module mới và các providers phụ thuộc module cũ
+//  3. Register và boot module mới, rollback về module cũ nếu boot thất bại
+//  4. Teardown module cũ qua Application.ReplaceProvider()
+//
+// Tham số:
+//   - old: interface{} - Module đang được sử dụng (phải là di.ServiceProvider)
+//   - replacement: interface{} - Module thay thế (phải là di.ServiceProvider)
+//
+// Trả về:
+//   - error: ModuleLoadError nếu module không hợp lệ, hoặc lỗi từ Application.ReplaceProvider()
+func (l *moduleLoader) ReplaceModule(old, replacement interface{}) error {
+	oldProvider, ok := old.(di.ServiceProvider)
+	if !ok {
+		return newModuleLoadError(old, "module must implement di.ServiceProvider interface", nil)
+	}
+	newProvider, ok := replacement.(di.ServiceProvider)
+	if !ok {
+		return newModuleLoadError(replacement, "module must implement di.ServiceProvider interface", nil)
+	}
+
+	return l.app.ReplaceProvider(context.Background(), oldProvider, newProvider)
+}
+
 // isAppBooted kiểm tra xem application đã được booted chưa.
 //
-// Trả về true nếu app đã booted, false nếu chưa.
+// Trả về true nếu tất cả providers đã boot thành công (StateBooted).
 func (l *moduleLoader) isAppBooted() bool {
-	// Chúng ta không thể truy cập trực tiếp private field booted
-	// Thay vào đó, chúng ta có thể sử dụng một thuộc tính khác để xác định trạng thái booted
-	// Ví dụ: Kiểm tra xem log service đã được đăng ký chưa (một dịch vụ core được đăng ký khi boot)
-	_, err := l.app.Make("log")
-	return err == nil
+	return l.app.State() == StateBooted
 }
 
 // ModuleLoadError represent lỗi khi load một module.
@@ -219,16 +708,51 @@ func (l *moduleLoader) isAppBooted() bool {
 // Error type này cung cấp thông tin chi tiết về module nào gây lỗi
 // và lý do tại sao việc load thất bại.
 type ModuleLoadError struct {
+	// Module là module load thất bại
 	Module interface{}
+	// Reason là lý do load thất bại, có thể rỗng khi Cause đã mô tả đủ
 	Reason string
+	// Cause là lỗi gốc, nil nếu module bị từ chối trước khi load
+	Cause error
+	// Key là provider key (type@address) nếu module là di.ServiceProvider, rỗng nếu không
+	Key string
+}
+
+// newModuleLoadError tạo ModuleLoadError với Key của module.
+//
+// Tham số:
+//   - module: interface{} - Module load thất bại
+//   - reason: string - Lý do, rỗng nếu cause đã mô tả đủ
+//   - cause: error - Lỗi gốc, nil nếu module bị từ chối trước khi load
+//
+// Trả về:
+//   - *ModuleLoadError: Lỗi load module
+func newModuleLoadError(module interface{}, reason string, cause error) *ModuleLoadError {
+	err := &ModuleLoadError{Module: module, Reason: reason, Cause: cause}
+	if provider, ok := module.(di.ServiceProvider); ok {
+		err.Key = getProviderKey(provider)
+	}
+	return err
 }
 
 // Error implement error interface.
 //
 // Trả về:
-//   - string: Error message với thông tin chi tiết
+//   - string: Error message với tên hoặc giá trị của module, lý do và lỗi gốc
 func (e *ModuleLoadError) Error() string {
-	return "failed to load module: " + e.Reason
+	message := "failed to load module " + moduleIdentity(e.Module)
+	if e.Reason != "" {
+		message += ": " + e.Reason
+	}
+	if e.Cause != nil {
+		message += ": " + e.Cause.Error()
+	}
+	return message
+}
+
+// Unwrap trả về lỗi gốc để hỗ trợ errors.Is và errors.As.
+func (e *ModuleLoadError) Unwrap() error {
+	return e.Cause
 }
 
 // MultiModuleLoadError represent lỗi khi load multiple modules.
@@ -236,15 +760,41 @@ func (e *ModuleLoadError) Error() string {
 // Error type này cung cấp thông tin về module nào trong danh sách
 // gây ra lỗi và nguyên nhân gốc.
 type MultiModuleLoadError struct {
-	FailedIndex  int
+	// FailedIndex là vị trí của module thất bại trong danh sách
+	FailedIndex int
+	// FailedModule là module thất bại
 	FailedModule interface{}
-	Cause        error
+	// Cause là nguyên nhân, thường là *ModuleLoadError
+	Cause error
 }
 
 // Error implement error interface.
 //
 // Trả về:
-//   - string: Error message với thông tin chi tiết
+//   - string: Error message với index, tên hoặc giá trị của module và nguyên nhân
 func (e *MultiModuleLoadError) Error() string {
-	return fmt.Sprintf("failed to load modules: error at index %d, module failed: %v", e.FailedIndex, e.Cause)
+	return fmt.Sprintf("failed to load modules: error at index %d (%s), module failed: %v",
+		e.FailedIndex, moduleIdentity(e.FailedModule), e.Cause)
+}
+
+// Unwrap trả về nguyên nhân để hỗ trợ errors.Is và errors.As.
+func (e *MultiModuleLoadError) Unwrap() error {
+	return e.Cause
+}
+
+// moduleIdentity trả về định danh dễ đọc của module cho error messages.
+//
+// Tham số:
+//   - module: interface{} - Module cần định danh
+//
+// Trả về:
+//   - string: Tên provider với ServiceProvider, dạng "type(value)" với các giá trị khác
+func moduleIdentity(module interface{}) string {
+	if module == nil {
+		return "<nil>"
+	}
+	if provider, ok := module.(di.ServiceProvider); ok {
+		return providerName(provider)
+	}
+	return fmt.Sprintf("%T(%v)", module, module)
 }
@@ -1,8 +1,13 @@
 package core_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"os"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -508,10 +513,58 @@ func TestModuleLoader_ErrorTypes(t *testing.T) {
 			Reason: "test reason",
 		}
 
-		assert.Equal(t, "failed to load module: test reason", err.Error())
+		assert.Equal(t, "failed to load module string(test-module): test reason", err.Error())
 		assert.Contains(t, err.Error(), "test reason")
 	})
 
+	t.Run("module_load_error_includes_provider_name_and_cause", func(t *testing.T) {
+		t.Parallel()
+
+		provider := newLifecycleProvider("cache")
+		cause := errors.New("connection refused")
+		err := &core.ModuleLoadError{Module: provider, Cause: cause}
+
+		assert.Equal(t, "failed to load module *core_test.lifecycleProvider: connection refused", err.Error())
+		assert.ErrorIs(t, err, cause)
+	})
+
+	t.Run("multi_module_load_error_unwraps_to_cause", func(t *testing.T) {
+		t.Parallel()
+
+		cause := errors.New("boot failed")
+		err := &core.MultiModuleLoadError{
+			FailedIndex:  2,
+			FailedModule: 42,
+			Cause:        &core.ModuleLoadError{Module: 42, Cause: cause},
+		}
+
+		assert.Contains(t, err.Error(), "index 2 (int(42))")
+		assert.ErrorIs(t, err, cause)
+
+		var moduleErr *core.ModuleLoadError
+		require.True(t, errors.As(err, &moduleErr))
+		assert.Equal(t, 42, moduleErr.Module)
+	})
+
+	t.Run("load_module_wraps_runtime_load_failure", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		require.NoError(t, app.Boot())
+		provider := newLifecycleProvider("repository", "database")
+
+		err := app.ModuleLoader().LoadModule(provider)
+
+		var moduleErr *core.ModuleLoadError
+		require.True(t, errors.As(err, &moduleErr))
+		assert.Same(t, provider, moduleErr.Module)
+		assert.Regexp(t, `^\*core_test\.lifecycleProvider@0x[0-9a-f]+$`, moduleErr.Key)
+		assert.NotContains(t, err.Error(), moduleErr.Key)
+
+		var missingErr *core.MissingDependenciesError
+		assert.True(t, errors.As(err, &missingErr))
+	})
+
 	t.Run("multi_module_load_error_provides_detailed_message", func(t *testing.T) {
 		t.Parallel()
 
@@ -650,6 +703,773 @@ func TestModuleLoader_CoverageImprovements(t *testing.T) {
 	})
 }
 
+// yieldingProvider nhường goroutine khi graph đọc Requires, để các lần load
+// đồng thời xen kẽ giữa bước kiểm tra và bước thêm provider.
+type yieldingProvider struct {
+	lifecycleProvider
+}
+
+func (p *yieldingProvider) Requires() []string {
+	runtime.Gosched()
+	return p.lifecycleProvider.Requires()
+}
+
+// TestModuleLoader_RuntimeLoading tests dependency-aware loading after boot
+func TestModuleLoader_RuntimeLoading(t *testing.T) {
+	// bootedApp tạo application đã boot với provider cung cấp "database".
+	bootedApp := func(t *testing.T) (core.Application, *[]string) {
+		app := core.New(map[string]interface{}{})
+		order := &[]string{}
+
+		database := newLifecycleProvider("database")
+		database.shutdown = func(ctx context.Context, app di.Application) error {
+			*order = append(*order, "database")
+			return nil
+		}
+		app.Register(database)
+		require.NoError(t, app.Boot())
+		return app, order
+	}
+
+	// recordingProvider tạo provider ghi lại thứ tự boot và shutdown.
+	recordingProvider := func(order *[]string, provides string, requires ...string) *lifecycleProvider {
+		provider := newLifecycleProvider(provides, requires...)
+		provider.boot = func(ctx context.Context, app di.Application) error {
+			*order = append(*order, "boot:"+provides)
+			return nil
+		}
+		provider.shutdown = func(ctx context.Context, app di.Application) error {
+			*order = append(*order, provides)
+			return nil
+		}
+		return provider
+	}
+
+	t.Run("boots_late_module_with_satisfied_dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		app, order := bootedApp(t)
+		repository := recordingProvider(order, "repository", "database")
+
+		require.NoError(t, app.ModuleLoader().LoadModule(repository))
+		assert.Equal(t, []string{"boot:repository"}, *order)
+		assert.Len(t, app.ServiceProviders(), 2)
+	})
+
+	t.Run("rejects_late_module_with_missing_dependency", func(t *testing.T) {
+		t.Parallel()
+
+		app, order := bootedApp(t)
+		repository := recordingProvider(order, "repository", "databse")
+
+		err := app.ModuleLoader().LoadModule(repository)
+
+		var missingErr *core.MissingDependenciesError
+		require.True(t, errors.As(err, &missingErr))
+		assert.Equal(t, []string{"database"}, missingErr.Missing[0].Suggestions)
+		assert.Empty(t, *order)
+		assert.Len(t, app.ServiceProviders(), 1)
+	})
+
+	t.Run("loads_batch_in_dependency_order", func(t *testing.T) {
+		t.Parallel()
+
+		app, order := bootedApp(t)
+		handler := recordingProvider(order, "handler", "service")
+		service := recordingProvider(order, "service", "repository")
+		repository := recordingProvider(order, "repository", "database")
+
+		require.NoError(t, app.ModuleLoader().LoadModules(handler, service, repository))
+		assert.Equal(t, []string{"boot:repository", "boot:service", "boot:handler"}, *order)
+	})
+
+	t.Run("shutdown_releases_late_modules_before_dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		app, order := bootedApp(t)
+		service := recordingProvider(order, "service", "repository")
+		repository := recordingProvider(order, "repository", "database")
+
+		require.NoError(t, app.ModuleLoader().LoadModules(service, repository))
+		*order = (*order)[:0]
+
+		require.NoError(t, app.Shutdown(context.Background()))
+		assert.Equal(t, []string{"service", "repository", "database"}, *order)
+	})
+
+	t.Run("rejects_batch_with_cycle", func(t *testing.T) {
+		t.Parallel()
+
+		app, order := bootedApp(t)
+		a := recordingProvider(order, "a", "b")
+		b := recordingProvider(order, "b", "a")
+
+		err := app.ModuleLoader().LoadModules(a, b)
+		assert.ErrorContains(t, err, "circular dependency detected among service providers: *core_test.lifecycleProvider -> *core_test.lifecycleProvider -> *core_test.lifecycleProvider")
+		assert.Empty(t, *order)
+		assert.Len(t, app.ServiceProviders(), 1)
+	})
+
+	t.Run("registers_without_booting_before_app_boot", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		var order []string
+		repository := recordingProvider(&order, "repository", "database")
+
+		require.NoError(t, app.ModuleLoader().LoadModules(repository))
+		assert.Empty(t, order)
+		assert.Len(t, app.ServiceProviders(), 1)
+	})
+
+	t.Run("ignores_already_registered_module", func(t *testing.T) {
+		t.Parallel()
+
+		app, order := bootedApp(t)
+		repository := recordingProvider(order, "repository", "database")
+
+		require.NoError(t, app.ModuleLoader().LoadModule(repository))
+		require.NoError(t, app.ModuleLoader().LoadModule(repository))
+		assert.Equal(t, []string{"boot:repository"}, *order)
+	})
+
+	t.Run("rolls_back_batch_when_boot_fails", func(t *testing.T) {
+		t.Parallel()
+
+		app, order := bootedApp(t)
+		repository := recordingProvider(order, "repository", "database")
+		service := recordingProvider(order, "service", "repository")
+		bootErr := errors.New("connection refused")
+		service.boot = func(ctx context.Context, app di.Application) error {
+			return bootErr
+		}
+
+		err := app.LoadProviders(context.Background(), service, repository)
+		require.ErrorIs(t, err, bootErr)
+		assert.Equal(t, []string{"boot:repository", "repository"}, *order)
+		assert.Len(t, app.ServiceProviders(), 1)
+		assert.Len(t, app.BootReport().Providers, 1)
+
+		*order = (*order)[:0]
+		service.boot = func(ctx context.Context, app di.Application) error {
+			*order = append(*order, "boot:service")
+			return nil
+		}
+		require.NoError(t, app.LoadProviders(context.Background(), service, repository))
+		assert.Equal(t, []string{"boot:repository", "boot:service"}, *order)
+		assert.Len(t, app.ServiceProviders(), 3)
+	})
+
+	t.Run("wraps_batch_errors_with_failing_module", func(t *testing.T) {
+		t.Parallel()
+
+		app, order := bootedApp(t)
+		repository := recordingProvider(order, "repository", "database")
+		cache := newModuleProvider("cache", "1.0.0", nil)
+		cache.boot = func(ctx context.Context, app di.Application) error {
+			return errors.New("connection refused")
+		}
+
+		err := app.ModuleLoader().LoadModules(repository, cache)
+
+		var multiErr *core.MultiModuleLoadError
+		require.True(t, errors.As(err, &multiErr))
+		assert.Equal(t, 1, multiErr.FailedIndex)
+		assert.Same(t, cache, multiErr.FailedModule)
+		var loadErr *core.ModuleLoadError
+		require.True(t, errors.As(err, &loadErr))
+		assert.Contains(t, loadErr.Key, "*core_test.moduleProvider@")
+		var bootErr *core.ProviderBootError
+		assert.True(t, errors.As(err, &bootErr))
+		assert.Contains(t, err.Error(), "error at index 1 (*core_test.moduleProvider)")
+	})
+
+	t.Run("reads_providers_while_loading", func(t *testing.T) {
+		t.Parallel()
+
+		app, _ := bootedApp(t)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 20; i++ {
+				provider := newLifecycleProvider(fmt.Sprintf("service.%d", i), "database")
+				assert.NoError(t, app.LoadProviders(context.Background(), provider))
+			}
+		}()
+
+		for loading := true; loading; {
+			select {
+			case <-done:
+				loading = false
+			default:
+			}
+			app.Health(context.Background())
+			app.BootReport()
+			app.Plan()
+		}
+		assert.Len(t, app.ServiceProviders(), 21)
+	})
+
+	t.Run("loads_provider_once_when_called_concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		app, _ := bootedApp(t)
+		provider := &yieldingProvider{lifecycleProvider: *newLifecycleProvider("cache", "database")}
+
+		start := make(chan struct{})
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				assert.NoError(t, app.LoadProviders(context.Background(), provider))
+			}()
+		}
+		close(start)
+		wg.Wait()
+
+		assert.Len(t, app.ServiceProviders(), 2)
+		assert.Len(t, app.BootReport().Providers, 2)
+	})
+}
+
+// TestModuleLoader_UnloadModule tests removing modules at runtime
+func TestModuleLoader_UnloadModule(t *testing.T) {
+	// bootedApp tạo application đã boot với database và repository (require database).
+	bootedApp := func(t *testing.T) (core.Application, *lifecycleProvider, *lifecycleProvider, *[]string) {
+		app := core.New(map[string]interface{}{})
+		shutdowns := &[]string{}
+
+		database := newLifecycleProvider("database")
+		database.shutdown = func(ctx context.Context, app di.Application) error {
+			*shutdowns = append(*shutdowns, "database")
+			return nil
+		}
+		repository := newLifecycleProvider("repository", "database")
+		repository.shutdown = func(ctx context.Context, app di.Application) error {
+			*shutdowns = append(*shutdowns, "repository")
+			return nil
+		}
+
+		app.Register(database)
+		app.Register(repository)
+		require.NoError(t, app.Boot())
+		return app, database, repository, shutdowns
+	}
+
+	t.Run("unloads_module_without_dependents", func(t *testing.T) {
+		t.Parallel()
+
+		app, database, repository, shutdowns := bootedApp(t)
+
+		require.NoError(t, app.ModuleLoader().UnloadModule(repository))
+		assert.Equal(t, []string{"repository"}, *shutdowns)
+		assert.Equal(t, []di.ServiceProvider{database}, app.ServiceProviders())
+		assert.Len(t, app.BootReport().Providers, 1)
+
+		require.NoError(t, app.Shutdown(context.Background()))
+		assert.Equal(t, []string{"repository", "database"}, *shutdowns)
+	})
+
+	t.Run("rejects_module_with_dependents", func(t *testing.T) {
+		t.Parallel()
+
+		app, database, _, shutdowns := bootedApp(t)
+
+		err := app.ModuleLoader().UnloadModule(database)
+
+		var inUseErr *core.ProviderInUseError
+		require.True(t, errors.As(err, &inUseErr))
+		require.Len(t, inUseErr.Dependents, 1)
+		assert.Equal(t, []string{"database"}, inUseErr.Dependents[0].Services)
+		assert.Contains(t, err.Error(), "cannot unload service provider *core_test.lifecycleProvider: still required by *core_test.lifecycleProvider ('database')")
+		assert.Empty(t, *shutdowns)
+		assert.Len(t, app.ServiceProviders(), 2)
+	})
+
+	t.Run("keeps_module_when_teardown_fails", func(t *testing.T) {
+		t.Parallel()
+
+		app, _, repository, _ := bootedApp(t)
+		repository.shutdown = func(ctx context.Context, app di.Application) error {
+			return errors.New("connection busy")
+		}
+
+		err := app.ModuleLoader().UnloadModule(repository)
+
+		var shutdownErr *core.ProviderShutdownError
+		require.True(t, errors.As(err, &shutdownErr))
+		assert.Len(t, app.ServiceProviders(), 2)
+	})
+
+	t.Run("allows_unload_when_service_provided_by_another_module", func(t *testing.T) {
+		t.Parallel()
+
+		app, database, _, _ := bootedApp(t)
+		replica := newLifecycleProvider("database")
+		require.NoError(t, app.ModuleLoader().LoadModule(replica))
+
+		assert.NoError(t, app.ModuleLoader().UnloadModule(database))
+		assert.Len(t, app.ServiceProviders(), 2)
+	})
+
+	t.Run("forgets_bindings_of_unloaded_module", func(t *testing.T) {
+		t.Parallel()
+
+		app, _, _, _ := bootedApp(t)
+		cache := newBindingProvider("cache", "redis")
+		require.NoError(t, app.ModuleLoader().LoadModule(cache))
+		app.Alias("cache", "cache.store")
+		require.True(t, app.Container().Bound("cache"))
+
+		require.NoError(t, app.ModuleLoader().UnloadModule(cache))
+		assert.False(t, app.Container().Bound("cache"))
+		assert.False(t, app.Container().Bound("cache.store"))
+		_, err := app.Make("cache")
+		assert.ErrorContains(t, err, "service 'cache' has been unloaded")
+		_, err = app.Make("cache.store")
+		assert.Error(t, err)
+
+		require.NoError(t, app.ModuleLoader().LoadModule(cache))
+		assert.True(t, app.Container().Bound("cache"))
+		instance, err := app.Make("cache")
+		require.NoError(t, err)
+		assert.Equal(t, "redis", instance)
+	})
+
+	t.Run("forgets_undeclared_bindings_and_aliases_of_unloaded_module", func(t *testing.T) {
+		t.Parallel()
+
+		app, _, _, _ := bootedApp(t)
+		cache := &registeringProvider{
+			lifecycleProvider: *newLifecycleProvider("cache"),
+			register: func(app di.Application) {
+				app.Instance("cache", "redis")
+				app.Instance("cache.metrics", "hits")
+				app.Alias("cache", "cache.store")
+			},
+		}
+		require.NoError(t, app.ModuleLoader().LoadModule(cache))
+		require.True(t, app.Container().Bound("cache.metrics"))
+		require.True(t, app.Container().Bound("cache.store"))
+
+		require.NoError(t, app.ModuleLoader().UnloadModule(cache))
+		assert.False(t, app.Container().Bound("cache"))
+		assert.False(t, app.Container().Bound("cache.metrics"))
+		assert.False(t, app.Container().Bound("cache.store"))
+	})
+
+	t.Run("restores_bindings_shadowed_by_unloaded_module", func(t *testing.T) {
+		t.Parallel()
+
+		app, _, _, _ := bootedApp(t)
+		app.Instance("logger", "default")
+		memory := newBindingProvider("cache", "memory")
+		require.NoError(t, app.ModuleLoader().LoadModule(memory))
+		redis := &registeringProvider{
+			lifecycleProvider: *newLifecycleProvider("cache"),
+			register: func(app di.Application) {
+				app.Instance("cache", "redis")
+				app.Instance("logger", "json")
+			},
+		}
+		require.NoError(t, app.ModuleLoader().LoadModule(redis))
+		assert.Equal(t, "redis", app.MustMake("cache"))
+		assert.Equal(t, "json", app.MustMake("logger"))
+
+		require.NoError(t, app.ModuleLoader().UnloadModule(redis))
+		assert.Equal(t, "memory", app.MustMake("cache"))
+		assert.Equal(t, "default", app.MustMake("logger"))
+	})
+
+	t.Run("keeps_bindings_overridden_by_later_module", func(t *testing.T) {
+		t.Parallel()
+
+		app, _, _, _ := bootedApp(t)
+		memory := newBindingProvider("cache", "memory")
+		redis := newBindingProvider("cache", "redis")
+		require.NoError(t, app.ModuleLoader().LoadModule(memory))
+		require.NoError(t, app.ModuleLoader().LoadModule(redis))
+
+		require.NoError(t, app.ModuleLoader().UnloadModule(memory))
+		assert.Equal(t, "redis", app.MustMake("cache"))
+
+		require.NoError(t, app.ModuleLoader().UnloadModule(redis))
+		assert.False(t, app.Container().Bound("cache"))
+	})
+
+	t.Run("unloads_module_before_boot_without_teardown", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		tornDown := false
+		provider := newLifecycleProvider("cache")
+		provider.shutdown = func(ctx context.Context, app di.Application) error {
+			tornDown = true
+			return nil
+		}
+		require.NoError(t, app.ModuleLoader().LoadModule(provider))
+
+		require.NoError(t, app.ModuleLoader().UnloadModule(provider))
+		assert.False(t, tornDown)
+		assert.Empty(t, app.ServiceProviders())
+	})
+
+	t.Run("returns_error_for_unregistered_module", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		err := app.ModuleLoader().UnloadModule(newLifecycleProvider("cache"))
+		assert.ErrorContains(t, err, "is not registered")
+	})
+
+	t.Run("returns_error_for_invalid_module", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		err := app.ModuleLoader().UnloadModule("not a provider")
+
+		var loadErr *core.ModuleLoadError
+		assert.True(t, errors.As(err, &loadErr))
+	})
+}
+
+// bindingProvider là lifecycleProvider bind instance cho service của nó khi Register.
+type bindingProvider struct {
+	lifecycleProvider
+	instance interface{}
+}
+
+func (p *bindingProvider) Register(app di.Application) {
+	for _, service := range p.provides {
+		app.Instance(service, p.instance)
+	}
+}
+
+// newBindingProvider tạo bindingProvider boot thành công.
+func newBindingProvider(provides string, instance interface{}, requires ...string) *bindingProvider {
+	return &bindingProvider{lifecycleProvider: *newLifecycleProvider(provides, requires...), instance: instance}
+}
+
+// TestModuleLoader_ReplaceModule tests hot-swapping modules at runtime
+func TestModuleLoader_ReplaceModule(t *testing.T) {
+	// bootedApp tạo application đã boot với database và repository (require database).
+	bootedApp := func(t *testing.T) (core.Application, *bindingProvider, *[]string) {
+		app := core.New(map[string]interface{}{})
+		shutdowns := &[]string{}
+
+		database := newBindingProvider("database", "primary")
+		database.shutdown = func(ctx context.Context, app di.Application) error {
+			*shutdowns = append(*shutdowns, "primary")
+			return nil
+		}
+		repository := newBindingProvider("repository", "repository", "database")
+
+		app.Register(database)
+		app.Register(repository)
+		require.NoError(t, app.Boot())
+		return app, database, shutdowns
+	}
+
+	t.Run("swaps_bindings_and_tears_down_old_module", func(t *testing.T) {
+		t.Parallel()
+
+		app, database, shutdowns := bootedApp(t)
+		replacement := newBindingProvider("database", "rotated")
+
+		require.NoError(t, app.ModuleLoader().ReplaceModule(database, replacement))
+
+		assert.Equal(t, "rotated", app.MustMake("database"))
+		assert.Equal(t, []string{"primary"}, *shutdowns)
+		providers := app.ServiceProviders()
+		require.Len(t, providers, 2)
+		assert.Same(t, replacement, providers[0])
+	})
+
+	t.Run("concurrent_make_sees_all_old_or_all_new_bindings", func(t *testing.T) {
+		t.Parallel()
+
+		// credentials tạo provider bind user và password cùng version.
+		credentials := func(version string) *registeringProvider {
+			provider := &registeringProvider{
+				lifecycleProvider: *newLifecycleProvider("database.user"),
+				register: func(app di.Application) {
+					app.Instance("database.user", version+"-user")
+					app.Instance("database.password", version+"-password")
+				},
+			}
+			provider.provides = []string{"database.user", "database.password"}
+			return provider
+		}
+
+		app := core.New(map[string]interface{}{})
+		app.Bind("database.dsn", func(c di.Container) interface{} {
+			user := c.MustMake("database.user").(string)
+			runtime.Gosched()
+			return user + ":" + c.MustMake("database.password").(string)
+		})
+		current := credentials("v0")
+		app.Register(current)
+		require.NoError(t, app.Boot())
+
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case <-done:
+						return
+					default:
+					}
+					dsn := app.MustMake("database.dsn").(string)
+					user, password, _ := strings.Cut(dsn, ":")
+					if strings.TrimSuffix(user, "-user") != strings.TrimSuffix(password, "-password") {
+						assert.Fail(t, "resolved mixed bindings", dsn)
+						return
+					}
+				}
+			}()
+		}
+
+		for i := 1; i <= 20; i++ {
+			next := credentials(fmt.Sprintf("v%d", i))
+			require.NoError(t, app.ReplaceProvider(context.Background(), current, next))
+			current = next
+			runtime.Gosched()
+		}
+		close(done)
+		wg.Wait()
+		assert.Equal(t, "v20-user:v20-password", app.MustMake("database.dsn"))
+	})
+
+	t.Run("rolls_back_when_replacement_fails_to_boot", func(t *testing.T) {
+		t.Parallel()
+
+		app, database, shutdowns := bootedApp(t)
+		replacement := newBindingProvider("database", "broken")
+		replacement.boot = func(ctx context.Context, app di.Application) error {
+			return errors.New("invalid credentials")
+		}
+
+		err := app.ModuleLoader().ReplaceModule(database, replacement)
+
+		var bootErr *core.ProviderBootError
+		require.True(t, errors.As(err, &bootErr))
+		assert.Contains(t, err.Error(), "rolled back")
+		assert.Equal(t, "primary", app.MustMake("database"))
+		assert.Empty(t, *shutdowns)
+		assert.Same(t, database, app.ServiceProviders()[0])
+	})
+
+	t.Run("stages_bindings_until_replacement_boots", func(t *testing.T) {
+		t.Parallel()
+
+		app, database, _ := bootedApp(t)
+		replacement := newBindingProvider("database", "rotated")
+		var staged, live interface{}
+		replacement.boot = func(ctx context.Context, staging di.Application) error {
+			staged = staging.MustMake("database")
+			live = app.MustMake("database")
+			return nil
+		}
+
+		require.NoError(t, app.ModuleLoader().ReplaceModule(database, replacement))
+		assert.Equal(t, "rotated", staged)
+		assert.Equal(t, "primary", live)
+		assert.Equal(t, "rotated", app.MustMake("database"))
+	})
+
+	t.Run("keeps_original_binding_definitions_on_rollback", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		created := 0
+		connections := newBindingProvider("connection", nil)
+		app.Register(connections)
+		require.NoError(t, app.Boot())
+		app.Bind("connection", func(c di.Container) interface{} {
+			created++
+			return created
+		})
+
+		replacement := newBindingProvider("connection", "broken")
+		replacement.boot = func(ctx context.Context, app di.Application) error {
+			return errors.New("invalid credentials")
+		}
+		require.Error(t, app.ModuleLoader().ReplaceModule(connections, replacement))
+
+		assert.Zero(t, created)
+		assert.Equal(t, 1, app.MustMake("connection"))
+		assert.Equal(t, 2, app.MustMake("connection"))
+	})
+
+	t.Run("shutdown_releases_replacement_after_dependents", func(t *testing.T) {
+		t.Parallel()
+
+		app, database, shutdowns := bootedApp(t)
+		replacement := newBindingProvider("database", "rotated")
+		replacement.shutdown = func(ctx context.Context, app di.Application) error {
+			*shutdowns = append(*shutdowns, "rotated")
+			return nil
+		}
+		require.NoError(t, app.ModuleLoader().ReplaceModule(database, replacement))
+
+		require.NoError(t, app.Shutdown(context.Background()))
+		assert.Equal(t, []string{"primary", "rotated"}, *shutdowns)
+	})
+
+	t.Run("rejects_replacement_dropping_required_service", func(t *testing.T) {
+		t.Parallel()
+
+		app, database, shutdowns := bootedApp(t)
+		replacement := newBindingProvider("cache", "cache")
+
+		err := app.ModuleLoader().ReplaceModule(database, replacement)
+
+		var inUseErr *core.ProviderInUseError
+		require.True(t, errors.As(err, &inUseErr))
+		assert.Empty(t, *shutdowns)
+		assert.Equal(t, "primary", app.MustMake("database"))
+	})
+
+	t.Run("rejects_replacement_with_missing_dependency", func(t *testing.T) {
+		t.Parallel()
+
+		app, database, _ := bootedApp(t)
+		replacement := newBindingProvider("database", "rotated", "vault")
+
+		err := app.ModuleLoader().ReplaceModule(database, replacement)
+
+		var missingErr *core.MissingDependenciesError
+		assert.True(t, errors.As(err, &missingErr))
+	})
+
+	t.Run("replaces_module_before_boot", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		database := newBindingProvider("database", "primary")
+		replacement := newBindingProvider("database", "rotated")
+		app.Register(database)
+
+		require.NoError(t, app.ModuleLoader().ReplaceModule(database, replacement))
+		require.NoError(t, app.Boot())
+		assert.Equal(t, "rotated", app.MustMake("database"))
+	})
+
+	t.Run("returns_error_for_unregistered_module", func(t *testing.T) {
+		t.Parallel()
+
+		app, _, _ := bootedApp(t)
+
+		err := app.ModuleLoader().ReplaceModule(newBindingProvider("cache", "a"), newBindingProvider("cache", "b"))
+		assert.ErrorContains(t, err, "is not registered")
+	})
+
+	t.Run("returns_error_for_invalid_module", func(t *testing.T) {
+		t.Parallel()
+
+		app, database, _ := bootedApp(t)
+
+		err := app.ModuleLoader().ReplaceModule(database, "not a provider")
+
+		var loadErr *core.ModuleLoadError
+		assert.True(t, errors.As(err, &loadErr))
+	})
+}
+
+// TestModuleLoader_LoadModulesContinueOnError tests collecting every failed module
+func TestModuleLoader_LoadModulesContinueOnError(t *testing.T) {
+	continueConfig := map[string]interface{}{"app.modules.continue_on_error": true}
+
+	t.Run("registers_valid_modules_and_reports_every_invalid_index", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, continueConfig)
+		cache := newLifecycleProvider("cache")
+		queue := newLifecycleProvider("queue")
+
+		err := app.ModuleLoader().LoadModules("invalid", cache, 42, queue)
+		require.Error(t, err)
+
+		joined, ok := err.(interface{ Unwrap() []error })
+		require.True(t, ok)
+		errs := joined.Unwrap()
+		require.Len(t, errs, 2)
+
+		var first, second *core.MultiModuleLoadError
+		require.True(t, errors.As(errs[0], &first))
+		require.True(t, errors.As(errs[1], &second))
+		assert.Equal(t, 0, first.FailedIndex)
+		assert.Equal(t, 2, second.FailedIndex)
+		assert.Len(t, app.ServiceProviders(), 2)
+	})
+
+	t.Run("loads_dependencies_given_later_in_list_after_boot", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, continueConfig)
+		require.NoError(t, app.Boot())
+		var order []string
+		repository := newLifecycleProvider("repository", "database")
+		repository.boot = func(ctx context.Context, app di.Application) error {
+			order = append(order, "repository")
+			return nil
+		}
+		database := newLifecycleProvider("database")
+		database.boot = func(ctx context.Context, app di.Application) error {
+			order = append(order, "database")
+			return nil
+		}
+
+		require.NoError(t, app.ModuleLoader().LoadModules(repository, database))
+		assert.Equal(t, []string{"database", "repository"}, order)
+	})
+
+	t.Run("continues_past_failed_modules_after_boot", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, continueConfig)
+		require.NoError(t, app.Boot())
+		bootErr := errors.New("boot failed")
+		broken := newLifecycleProvider("broken")
+		broken.boot = func(ctx context.Context, app di.Application) error {
+			return bootErr
+		}
+		orphan := newLifecycleProvider("orphan", "missing")
+		cache := newLifecycleProvider("cache")
+
+		err := app.ModuleLoader().LoadModules(broken, orphan, cache)
+
+		assert.ErrorIs(t, err, bootErr)
+		var missingErr *core.MissingDependenciesError
+		assert.True(t, errors.As(err, &missingErr))
+		assert.Contains(t, err.Error(), "index 0")
+		assert.Contains(t, err.Error(), "index 1")
+		assert.NotContains(t, err.Error(), "index 2")
+		assert.Contains(t, app.ServiceProviders(), di.ServiceProvider(cache))
+	})
+
+	t.Run("stops_at_first_invalid_module_by_default", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{})
+
+		err := app.ModuleLoader().LoadModules("invalid", newLifecycleProvider("cache"), 42)
+
+		var multiErr *core.MultiModuleLoadError
+		require.True(t, errors.As(err, &multiErr))
+		assert.Equal(t, 0, multiErr.FailedIndex)
+		assert.Empty(t, app.ServiceProviders())
+	})
+}
+
 // BenchmarkModuleLoader_RegisterCoreProviders benchmarks core provider registration
 func BenchmarkModuleLoader_RegisterCoreProviders(b *testing.B) {
 	setupTestEnvironment(&testing.T{})
@@ -0,0 +1,307 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.fork.vn/di"
+)
+
+// ModuleManifest là metadata mô tả một module.
+type ModuleManifest struct {
+	// Name là tên duy nhất của module, ví dụ "billing"
+	Name string `json:"name"`
+	// Version là semantic version của module, ví dụ "1.4.0"
+	Version string `json:"version"`
+	// Description là mô tả ngắn của module
+	Description string `json:"description,omitempty"`
+	// MinCoreVersion là version tối thiểu của go.fork.vn/core mà module cần, rỗng nếu không giới hạn
+	MinCoreVersion string `json:"min_core_version,omitempty"`
+	// Requires map tên module tới version constraint, ví dụ {"auth": "^1.2.0"}
+	Requires map[string]string `json:"requires,omitempty"`
+	// Provider là tên (type) của provider, được điền bởi Modules()
+	Provider string `json:"provider"`
+}
+
+// Module là interface tùy chọn cho service provider cung cấp metadata.
+//
+// Manifest của các modules được kiểm tra khi LoadModule(), LoadModules() và
+// BootstrapApplication(): tên phải duy nhất, version hợp lệ, version của core
+// phải đạt MinCoreVersion và các modules được require phải được load với
+// version thỏa mãn constraint.
+type Module interface {
+	// Manifest trả về metadata của module.
+	//
+	// Trả về:
+	//   - ModuleManifest: Metadata của module
+	Manifest() ModuleManifest
+}
+
+// ModuleConflict mô tả một vấn đề tương thích giữa các modules.
+type ModuleConflict struct {
+	// Module là tên module có vấn đề (tên provider nếu manifest không có tên)
+	Module string
+	// Dependency là "core" hoặc tên module được require, rỗng với lỗi của chính manifest
+	Dependency string
+	// Constraint là version constraint được yêu cầu
+	Constraint string
+	// Found là version tìm thấy, rỗng nếu dependency không được load
+	Found string
+	// Reason là mô tả vấn đề
+	Reason string
+
+	// provider là key của provider khai báo module
+	provider string
+}
+
+// String trả về mô tả một dòng của conflict.
+//
+// Trả về:
+//   - string: Mô tả dạng "module 'name': reason"
+func (c ModuleConflict) String() string {
+	return fmt.Sprintf("module '%s': %s", c.Module, c.Reason)
+}
+
+// ModuleCompatibilityError chứa tất cả vấn đề tương thích tìm được giữa các modules.
+type ModuleCompatibilityError struct {
+	// Conflicts là các vấn đề theo thứ tự đăng ký của modules
+	Conflicts []ModuleConflict
+}
+
+// Error implement error interface.
+//
+// Trả về:
+//   - string: Mô tả conflict duy nhất, hoặc danh sách tất cả trên từng dòng
+func (e *ModuleCompatibilityError) Error() string {
+	if len(e.Conflicts) == 1 {
+		return "incompatible module: " + e.Conflicts[0].String()
+	}
+
+	lines := make([]string, 0, len(e.Conflicts)+1)
+	lines = append(lines, fmt.Sprintf("%d module compatibility problems:", len(e.Conflicts)))
+	for _, conflict := range e.Conflicts {
+		lines = append(lines, "  - "+conflict.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Modules trả về manifest của các providers implement Module.
+//
+// Implement Application interface method.
+//
+// Trả về:
+//   - []ModuleManifest: Manifests theo thứ tự đăng ký, Provider được điền tên provider
+func (a *application) Modules() []ModuleManifest {
+	return moduleManifests(a.ServiceProviders())
+}
+
+// moduleManifests thu thập manifests của các providers implement Module.
+//
+// Tham số:
+//   - providers: []di.ServiceProvider - Providers theo thứ tự đăng ký
+//
+// Trả về:
+//   - []ModuleManifest: Manifests, mỗi provider instance một lần
+func moduleManifests(providers []di.ServiceProvider) []ModuleManifest {
+	manifests := make([]ModuleManifest, 0)
+	seen := make(map[string]bool)
+	for _, provider := range providers {
+		module, ok := provider.(Module)
+		key := getProviderKey(provider)
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		manifest := module.Manifest()
+		manifest.Provider = providerName(provider)
+		manifests = append(manifests, manifest)
+	}
+	return manifests
+}
+
+// checkModules kiểm tra tương thích giữa manifests của các providers.
+//
+// Tham số:
+//   - providers: []di.ServiceProvider - Providers cần kiểm tra
+//   - batch: map[string]bool - Chỉ báo vấn đề của các provider keys này, nil để báo tất cả
+//   - requires: bool - Kiểm tra Requires; false khi modules được require có thể được load sau
+//
+// Trả về:
+//   - error: *ModuleCompatibilityError nếu có vấn đề, nil nếu tương thích
+func checkModules(providers []di.ServiceProvider, batch map[string]bool, requires bool) error {
+	conflicts := make([]ModuleConflict, 0)
+	for _, conflict := range moduleConflicts(providers, requires) {
+		if batch == nil || batch[conflict.provider] {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+	return &ModuleCompatibilityError{Conflicts: conflicts}
+}
+
+// moduleEntry là manifest của một provider cùng version đã parse.
+type moduleEntry struct {
+	manifest ModuleManifest
+	provider di.ServiceProvider
+	version  semver
+	valid    bool
+}
+
+// moduleConflicts tìm tất cả vấn đề tương thích giữa manifests của các providers.
+//
+// Tham số:
+//   - providers: []di.ServiceProvider - Providers cần kiểm tra
+//   - requires: bool - Kiểm tra Requires của các manifests
+//
+// Trả về:
+//   - []ModuleConflict: Các vấn đề tìm được
+func moduleConflicts(providers []di.ServiceProvider, requires bool) []ModuleConflict {
+	entries := make([]*moduleEntry, 0)
+	seen := make(map[string]bool)
+	for _, provider := range providers {
+		module, ok := provider.(Module)
+		key := getProviderKey(provider)
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		entries = append(entries, &moduleEntry{manifest: module.Manifest(), provider: provider})
+	}
+
+	conflicts := make([]ModuleConflict, 0)
+	byName := make(map[string]*moduleEntry)
+	for _, e := range entries {
+		conflicts = append(conflicts, e.manifestConflicts(byName)...)
+		if e.manifest.Name != "" && byName[e.manifest.Name] == nil {
+			byName[e.manifest.Name] = e
+		}
+	}
+	for _, e := range entries {
+		if requires && e.manifest.Name != "" {
+			conflicts = append(conflicts, e.requireConflicts(byName)...)
+		}
+	}
+	return conflicts
+}
+
+// conflict tạo ModuleConflict cho module.
+//
+// Tham số:
+//   - dependency: string - "core", tên module được require hoặc rỗng
+//   - constraint: string - Version constraint được yêu cầu
+//   - found: string - Version tìm thấy
+//   - reason: string - Mô tả vấn đề
+//
+// Trả về:
+//   - ModuleConflict: Conflict gắn với provider của module
+func (e *moduleEntry) conflict(dependency, constraint, found, reason string) ModuleConflict {
+	name := e.manifest.Name
+	if name == "" {
+		name = providerName(e.provider)
+	}
+	return ModuleConflict{
+		Module:     name,
+		Dependency: dependency,
+		Constraint: constraint,
+		Found:      found,
+		Reason:     reason,
+		provider:   getProviderKey(e.provider),
+	}
+}
+
+// manifestConflicts kiểm tra tên, version và MinCoreVersion của manifest.
+//
+// Tham số:
+//   - loaded: map[string]*moduleEntry - Modules đã kiểm tra trước theo tên
+//
+// Trả về:
+//   - []ModuleConflict: Các vấn đề của manifest
+func (e *moduleEntry) manifestConflicts(loaded map[string]*moduleEntry) []ModuleConflict {
+	manifest := e.manifest
+	if manifest.Name == "" {
+		return []ModuleConflict{e.conflict("", "", "", "manifest name is empty")}
+	}
+
+	conflicts := make([]ModuleConflict, 0)
+	if other, exists := loaded[manifest.Name]; exists {
+		conflicts = append(conflicts, e.conflict("", "", manifest.Version,
+			fmt.Sprintf("is already loaded by %s (version %s)", providerName(other.provider), other.manifest.Version)))
+	}
+
+	if version, err := parseSemver(manifest.Version); err != nil {
+		conflicts = append(conflicts, e.conflict("", "", manifest.Version,
+			fmt.Sprintf("invalid version '%s'", manifest.Version)))
+	} else {
+		e.version, e.valid = version, true
+	}
+
+	if manifest.MinCoreVersion == "" {
+		return conflicts
+	}
+	minimum, err := parseSemver(manifest.MinCoreVersion)
+	if err != nil {
+		return append(conflicts, e.conflict("core", manifest.MinCoreVersion, Version,
+			fmt.Sprintf("invalid minimum core version '%s'", manifest.MinCoreVersion)))
+	}
+	if core, _ := parseSemver(Version); core.compare(minimum) < 0 {
+		conflicts = append(conflicts, e.conflict("core", ">="+manifest.MinCoreVersion, Version,
+			fmt.Sprintf("requires core >=%s, found %s", manifest.MinCoreVersion, Version)))
+	}
+	return conflicts
+}
+
+// requireConflicts kiểm tra các modules được require đã load với version phù hợp.
+//
+// Tham số:
+//   - loaded: map[string]*moduleEntry - Modules theo tên (module đăng ký trước thắng)
+//
+// Trả về:
+//   - []ModuleConflict: Các vấn đề của requires, theo thứ tự tên module được require
+func (e *moduleEntry) requireConflicts(loaded map[string]*moduleEntry) []ModuleConflict {
+	dependencies := make([]string, 0, len(e.manifest.Requires))
+	for dependency := range e.manifest.Requires {
+		dependencies = append(dependencies, dependency)
+	}
+	sort.Strings(dependencies)
+
+	conflicts := make([]ModuleConflict, 0)
+	for _, dependency := range dependencies {
+		text := e.manifest.Requires[dependency]
+		constraint, err := parseConstraint(text)
+		if err != nil {
+			conflicts = append(conflicts, e.conflict(dependency, text, "",
+				fmt.Sprintf("invalid version constraint '%s' for module '%s'", text, dependency)))
+			continue
+		}
+
+		required, exists := loaded[dependency]
+		if !exists {
+			conflicts = append(conflicts, e.conflict(dependency, text, "",
+				fmt.Sprintf("requires module '%s' %s, not loaded", dependency, constraintLabel(text))))
+			continue
+		}
+		if required.valid && !constraint.allows(required.version) {
+			conflicts = append(conflicts, e.conflict(dependency, text, required.manifest.Version,
+				fmt.Sprintf("requires module '%s' %s, found %s", dependency, constraintLabel(text), required.manifest.Version)))
+		}
+	}
+	return conflicts
+}
+
+// constraintLabel trả về constraint dễ đọc cho error messages.
+//
+// Tham số:
+//   - constraint: string - Version constraint
+//
+// Trả về:
+//   - string: Constraint, hoặc "*" nếu rỗng
+func constraintLabel(constraint string) string {
+	if strings.TrimSpace(constraint) == "" {
+		return "*"
+	}
+	return constraint
+}
@@ -0,0 +1,237 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+)
+
+// moduleProvider là service provider test implement core.Module.
+type moduleProvider struct {
+	*lifecycleProvider
+	manifest core.ModuleManifest
+}
+
+func (p *moduleProvider) Manifest() core.ModuleManifest {
+	return p.manifest
+}
+
+// newModuleProvider tạo moduleProvider cung cấp service cùng tên module.
+func newModuleProvider(name, version string, requires map[string]string) *moduleProvider {
+	return &moduleProvider{
+		lifecycleProvider: newLifecycleProvider(name),
+		manifest:          core.ModuleManifest{Name: name, Version: version, Requires: requires},
+	}
+}
+
+// compatibilityError lấy *core.ModuleCompatibilityError từ err.
+func compatibilityError(t *testing.T, err error) *core.ModuleCompatibilityError {
+	t.Helper()
+
+	var compatibilityErr *core.ModuleCompatibilityError
+	require.True(t, errors.As(err, &compatibilityErr), "expected ModuleCompatibilityError, got %v", err)
+	return compatibilityErr
+}
+
+// TestApplication_Modules tests the manifest of loaded modules
+func TestApplication_Modules(t *testing.T) {
+	t.Run("lists_manifests_in_registration_order", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newModuleProvider("auth", "1.2.0", nil))
+		app.Register(newLifecycleProvider("anonymous"))
+		app.Register(newModuleProvider("billing", "2.0.1", map[string]string{"auth": "^1.0.0"}))
+
+		modules := app.Modules()
+		require.Len(t, modules, 2)
+		assert.Equal(t, "auth", modules[0].Name)
+		assert.Equal(t, "*core_test.moduleProvider", modules[0].Provider)
+		assert.Equal(t, "billing", modules[1].Name)
+		assert.Equal(t, map[string]string{"auth": "^1.0.0"}, modules[1].Requires)
+	})
+
+	t.Run("info_endpoint_includes_modules", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newModuleProvider("auth", "1.2.0", nil))
+
+		var info core.AppInfo
+		getAdmin(t, core.NewAdminHandler(app), "/info", &info)
+		assert.Equal(t, core.Version, info.CoreVersion)
+		require.Len(t, info.Modules, 1)
+		assert.Equal(t, "1.2.0", info.Modules[0].Version)
+	})
+}
+
+// TestModuleLoader_Compatibility tests manifest checks when loading modules
+func TestModuleLoader_Compatibility(t *testing.T) {
+	setupTestEnvironment(t)
+
+	bootstrap := func(t *testing.T, providers ...*moduleProvider) error {
+		app := core.New(map[string]interface{}{
+			"file": "testdata/configs/console-only-simple.yaml",
+		})
+		for _, provider := range providers {
+			app.Register(provider)
+		}
+		return app.ModuleLoader().BootstrapApplication()
+	}
+
+	t.Run("bootstraps_compatible_modules", func(t *testing.T) {
+		t.Parallel()
+
+		err := bootstrap(t,
+			newModuleProvider("billing", "2.0.0", map[string]string{"auth": "^1.2.0", "audit": ">=0.3.1-beta.1, <1.0.0"}),
+			newModuleProvider("auth", "1.4.2", nil),
+			newModuleProvider("audit", "v0.3.1-beta.2", map[string]string{"auth": "~1.4"}),
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects_all_incompatibilities_at_bootstrap", func(t *testing.T) {
+		t.Parallel()
+
+		err := bootstrap(t,
+			newModuleProvider("billing", "2.0.0", map[string]string{"auth": "^2.0.0", "mailer": "*"}),
+			newModuleProvider("auth", "1.4.2", nil),
+		)
+		compatibilityErr := compatibilityError(t, err)
+		require.Len(t, compatibilityErr.Conflicts, 2)
+
+		conflict := compatibilityErr.Conflicts[0]
+		assert.Equal(t, "billing", conflict.Module)
+		assert.Equal(t, "auth", conflict.Dependency)
+		assert.Equal(t, "1.4.2", conflict.Found)
+		assert.Equal(t, "requires module 'mailer' *, not loaded", compatibilityErr.Conflicts[1].Reason)
+		assert.Contains(t, err.Error(), "2 module compatibility problems:")
+		assert.Contains(t, err.Error(), "module 'billing': requires module 'auth' ^2.0.0, found 1.4.2")
+	})
+
+	t.Run("rejects_newer_core_requirement", func(t *testing.T) {
+		t.Parallel()
+
+		provider := newModuleProvider("billing", "1.0.0", nil)
+		provider.manifest.MinCoreVersion = "99.0.0"
+
+		err := bootstrap(t, provider)
+		assert.EqualError(t, err, "incompatible module: module 'billing': requires core >=99.0.0, found "+core.Version)
+	})
+
+	t.Run("rejects_duplicate_and_invalid_manifest_on_load", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		require.NoError(t, app.ModuleLoader().LoadModule(newModuleProvider("auth", "1.0.0", nil)))
+
+		err := app.ModuleLoader().LoadModule(newModuleProvider("auth", "1.1.0", nil))
+		var loadErr *core.ModuleLoadError
+		require.True(t, errors.As(err, &loadErr))
+		assert.Contains(t, compatibilityError(t, err).Conflicts[0].Reason, "is already loaded by *core_test.moduleProvider (version 1.0.0)")
+
+		err = app.ModuleLoader().LoadModule(newModuleProvider("billing", "1.0", nil))
+		assert.Equal(t, "invalid version '1.0'", compatibilityError(t, err).Conflicts[0].Reason)
+		assert.Len(t, app.ServiceProviders(), 1)
+	})
+
+	t.Run("defers_requires_until_bootstrap", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		require.NoError(t, app.ModuleLoader().LoadModules(
+			newModuleProvider("billing", "1.0.0", map[string]string{"auth": "^1.0.0"}),
+			newModuleProvider("auth", "1.0.0", nil),
+		))
+		assert.Len(t, app.Modules(), 2)
+	})
+
+	t.Run("reports_failing_module_of_batch_before_boot", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		invalid := newModuleProvider("billing", "1.0", nil)
+
+		err := app.ModuleLoader().LoadModules(newModuleProvider("auth", "1.0.0", nil), invalid)
+
+		var multiErr *core.MultiModuleLoadError
+		require.True(t, errors.As(err, &multiErr))
+		assert.Equal(t, 1, multiErr.FailedIndex)
+		assert.Same(t, invalid, multiErr.FailedModule)
+		assert.Equal(t, "invalid version '1.0'", compatibilityError(t, err).Conflicts[0].Reason)
+		assert.Empty(t, app.ServiceProviders())
+	})
+
+	t.Run("rejects_incompatible_module_after_boot", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newModuleProvider("auth", "1.0.0", nil))
+		require.NoError(t, app.Boot())
+
+		billing := newModuleProvider("billing", "1.0.0", map[string]string{"auth": ">=1.1.0"})
+		billing.requires = []string{"auth"}
+
+		err := app.ModuleLoader().LoadModule(billing)
+		assert.Equal(t, ">=1.1.0", compatibilityError(t, err).Conflicts[0].Constraint)
+		assert.Len(t, app.Modules(), 1)
+
+		billing.manifest.Requires["auth"] = "1.x"
+		err = app.ModuleLoader().LoadModule(billing)
+		assert.Equal(t, "invalid version constraint '1.x' for module 'auth'", compatibilityError(t, err).Conflicts[0].Reason)
+
+		billing.manifest.Requires["auth"] = "^1"
+		require.NoError(t, app.ModuleLoader().LoadModule(billing))
+		assert.Len(t, app.Modules(), 2)
+	})
+}
+
+// TestModuleLoader_VersionConstraints tests matching module versions against Requires constraints
+func TestModuleLoader_VersionConstraints(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		version    string
+		allowed    bool
+	}{
+		{"caret_allows_same_major", "^1.2.0", "1.9.3", true},
+		{"caret_rejects_next_major", "^1.2.0", "2.0.0", false},
+		{"caret_zero_major_allows_same_minor", "^0.2.3", "0.2.9", true},
+		{"caret_zero_major_rejects_next_minor", "^0.2.3", "0.3.0", false},
+		{"caret_zero_minor_allows_same_patch", "^0.0.3", "0.0.3", true},
+		{"caret_zero_minor_rejects_next_patch", "^0.0.3", "0.0.4", false},
+		{"caret_partial_zero_major", "^0", "0.9.0", true},
+		{"tilde_allows_same_minor", "~1.2.0", "1.2.7", true},
+		{"tilde_rejects_next_minor", "~1.2.0", "1.3.0", false},
+		{"tilde_major_only_allows_same_major", "~1", "1.4.0", true},
+		{"operator_separated_by_space", ">= 1.0.0", "1.0.0", true},
+		{"range_separated_by_space", ">= 1.0.0 < 2.0.0", "2.0.0", false},
+		{"range_separated_by_comma", ">=1.0.0, <2.0.0", "1.5.0", true},
+		{"caret_rejects_prerelease_of_next_major", "^1.2.0", "2.0.0-beta", false},
+		{"range_rejects_prerelease_without_prerelease_bound", "<2.0.0", "1.5.0-rc.1", false},
+		{"prerelease_bound_allows_same_version_prerelease", ">=1.3.0-rc.1", "1.3.0-rc.2", true},
+		{"prerelease_bound_rejects_other_version_prerelease", ">=1.3.0-rc.1", "1.4.0-rc.1", false},
+		{"empty_constraint_allows_prerelease", "", "1.0.0-beta", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			app := core.New(map[string]interface{}{})
+			app.Register(newModuleProvider("auth", tt.version, nil))
+			require.NoError(t, app.Boot())
+
+			err := app.ModuleLoader().LoadModule(newModuleProvider("billing", "1.0.0", map[string]string{"auth": tt.constraint}))
+			if tt.allowed {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.constraint, compatibilityError(t, err).Conflicts[0].Constraint)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package core
+
+import (
+	"time"
+
+	"go.fork.vn/di"
+)
+
+// Tên các metrics mà application ghi nhận qua Metrics.
+const (
+	// MetricProviderRegisterDuration là histogram thời gian Register (giây), label "provider"
+	MetricProviderRegisterDuration = "core_provider_register_duration_seconds"
+	// MetricProviderBootDuration là histogram thời gian Boot (giây), labels "provider" và "result"
+	MetricProviderBootDuration = "core_provider_boot_duration_seconds"
+	// MetricBootDuration là gauge tổng thời gian boot lần gần nhất (giây)
+	MetricBootDuration = "core_boot_duration_seconds"
+	// MetricResolutions là counter số lần resolve qua Make/MustMake, label "abstract"
+	MetricResolutions = "core_resolutions_total"
+	// MetricResolutionDuration là histogram thời gian resolve (giây), label "abstract"
+	MetricResolutionDuration = "core_resolution_duration_seconds"
+	// MetricResolutionFailures là counter số lần resolve thất bại, label "abstract"
+	MetricResolutionFailures = "core_resolution_failures_total"
+	// MetricLifecycleState là gauge trạng thái lifecycle, label "state"; state hiện tại có giá trị 1
+	MetricLifecycleState = "core_lifecycle_state"
+)
+
+// Labels là các cặp label name, value của một metric.
+type Labels map[string]string
+
+// Metrics là interface ghi nhận metrics của application.
+//
+// Implementation phải an toàn khi gọi đồng thời. Application ghi nhận metrics
+// của lifecycle và container resolution qua interface này khi được cấu hình
+// bằng SetMetrics(); PrometheusMetrics là implementation có sẵn.
+type Metrics interface {
+	// AddCounter cộng delta vào counter.
+	//
+	// Tham số:
+	//   - name: string - Tên metric
+	//   - labels: Labels - Labels của series
+	//   - delta: float64 - Giá trị cộng thêm, không âm
+	AddCounter(name string, labels Labels, delta float64)
+
+	// ObserveHistogram ghi nhận một giá trị vào histogram.
+	//
+	// Tham số:
+	//   - name: string - Tên metric
+	//   - labels: Labels - Labels của series
+	//   - value: float64 - Giá trị quan sát được
+	ObserveHistogram(name string, labels Labels, value float64)
+
+	// SetGauge đặt giá trị hiện tại của gauge.
+	//
+	// Tham số:
+	//   - name: string - Tên metric
+	//   - labels: Labels - Labels của series
+	//   - value: float64 - Giá trị mới
+	SetGauge(name string, labels Labels, value float64)
+}
+
+// Metrics trả về metrics đã cấu hình qua SetMetrics().
+//
+// Implement Application interface method.
+//
+// Trả về:
+//   - Metrics: Metrics hiện tại, nil nếu chưa cấu hình
+func (a *application) Metrics() Metrics {
+	a.metricsMu.RLock()
+	defer a.metricsMu.RUnlock()
+	return a.metrics
+}
+
+// SetMetrics cấu hình metrics cho application.
+//
+// Implement Application interface method. Trạng thái lifecycle hiện tại được
+// ghi nhận ngay khi cấu hình.
+//
+// Tham số:
+//   - metrics: Metrics - Metrics implementation, nil để tắt
+func (a *application) SetMetrics(metrics Metrics) {
+	a.metricsMu.Lock()
+	a.metrics = metrics
+	a.metricsMu.Unlock()
+
+	a.recordState(a.State())
+}
+
+// observeResolution bắt đầu đo một lần resolve.
+//
+// Tham số:
+//   - abstract: string - Tên service được resolve
+//
+// Trả về:
+//   - func(bool): Gọi khi resolve xong với true nếu thành công
+func (a *application) observeResolution(abstract string) func(bool) {
+	metrics := a.Metrics()
+	if metrics == nil {
+		return func(bool) {}
+	}
+
+	start := time.Now()
+	return func(succeeded bool) {
+		labels := Labels{"abstract": abstract}
+		metrics.AddCounter(MetricResolutions, labels, 1)
+		metrics.ObserveHistogram(MetricResolutionDuration, labels, time.Since(start).Seconds())
+		if !succeeded {
+			metrics.AddCounter(MetricResolutionFailures, labels, 1)
+		}
+	}
+}
+
+// recordRegister ghi nhận thời gian Register của provider.
+//
+// Tham số:
+//   - provider: di.ServiceProvider - Provider đã register
+//   - elapsed: time.Duration - Thời gian Register
+func (a *application) recordRegister(provider di.ServiceProvider, elapsed time.Duration) {
+	if metrics := a.Metrics(); metrics != nil {
+		metrics.ObserveHistogram(MetricProviderRegisterDuration, Labels{"provider": providerName(provider)}, elapsed.Seconds())
+	}
+}
+
+// recordProviderBoot ghi nhận thời gian Boot của provider.
+//
+// Tham số:
+//   - provider: di.ServiceProvider - Provider đã boot
+//   - elapsed: time.Duration - Thời gian Boot
+//   - booted: bool - Provider boot thành công
+func (a *application) recordProviderBoot(provider di.ServiceProvider, elapsed time.Duration, booted bool) {
+	metrics := a.Metrics()
+	if metrics == nil {
+		return
+	}
+	result := "success"
+	if !booted {
+		result = "failure"
+	}
+	metrics.ObserveHistogram(MetricProviderBootDuration, Labels{"provider": providerName(provider), "result": result}, elapsed.Seconds())
+}
+
+// recordBootDuration ghi nhận tổng thời gian boot.
+//
+// Tham số:
+//   - elapsed: time.Duration - Tổng thời gian boot
+func (a *application) recordBootDuration(elapsed time.Duration) {
+	if metrics := a.Metrics(); metrics != nil {
+		metrics.SetGauge(MetricBootDuration, nil, elapsed.Seconds())
+	}
+}
+
+// recordState ghi nhận trạng thái lifecycle.
+//
+// Mỗi state có một series; state hiện tại có giá trị 1, các state khác 0.
+//
+// Tham số:
+//   - current: State - Trạng thái hiện tại
+func (a *application) recordState(current State) {
+	metrics := a.Metrics()
+	if metrics == nil {
+		return
+	}
+	for state := StateCreated; state <= StateShutdown; state++ {
+		value := 0.0
+		if state == current {
+			value = 1
+		}
+		metrics.SetGauge(MetricLifecycleState, Labels{"state": state.String()}, value)
+	}
+}
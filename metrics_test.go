@@ -0,0 +1,174 @@
+package core_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+)
+
+// TestPrometheusMetrics tests Prometheus text exposition of metrics
+func TestPrometheusMetrics(t *testing.T) {
+	t.Run("writes_counters_gauges_and_histograms", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := core.NewPrometheusMetrics(0.1, 1)
+		metrics.Describe("jobs_total", "Processed jobs.")
+		metrics.AddCounter("jobs_total", core.Labels{"queue": "mail"}, 2)
+		metrics.AddCounter("jobs_total", core.Labels{"queue": "mail"}, 1)
+		metrics.AddCounter("jobs_total", core.Labels{"queue": "mail"}, -5)
+		metrics.SetGauge("workers", nil, 4)
+		metrics.ObserveHistogram("latency_seconds", core.Labels{"route": `/a"b`}, 0.0625)
+		metrics.ObserveHistogram("latency_seconds", core.Labels{"route": `/a"b`}, 0.5)
+		metrics.ObserveHistogram("latency_seconds", core.Labels{"route": `/a"b`}, 3)
+
+		var out bytes.Buffer
+		n, err := metrics.WriteTo(&out)
+		require.NoError(t, err)
+		assert.Equal(t, int64(out.Len()), n)
+		assert.Equal(t, `# HELP jobs_total Processed jobs.
+# TYPE jobs_total counter
+jobs_total{queue="mail"} 3
+# TYPE latency_seconds histogram
+latency_seconds_bucket{route="/a\"b",le="0.1"} 1
+latency_seconds_bucket{route="/a\"b",le="1"} 2
+latency_seconds_bucket{route="/a\"b",le="+Inf"} 3
+latency_seconds_sum{route="/a\"b"} 3.5625
+latency_seconds_count{route="/a\"b"} 3
+# TYPE workers gauge
+workers 4
+`, out.String())
+	})
+
+	t.Run("ignores_metric_used_with_different_type", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := core.NewPrometheusMetrics()
+		metrics.AddCounter("requests", nil, 1)
+		metrics.SetGauge("requests", nil, 10)
+
+		var out bytes.Buffer
+		_, err := metrics.WriteTo(&out)
+		require.NoError(t, err)
+		assert.Equal(t, "# TYPE requests counter\nrequests 1\n", out.String())
+	})
+
+	t.Run("records_while_writer_blocks", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := core.NewPrometheusMetrics()
+		metrics.AddCounter("requests", nil, 1)
+
+		writer := &blockingWriter{started: make(chan struct{}), release: make(chan struct{})}
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = metrics.WriteTo(writer)
+		}()
+		<-writer.started
+
+		recorded := make(chan struct{})
+		go func() {
+			metrics.AddCounter("requests", nil, 1)
+			close(recorded)
+		}()
+		select {
+		case <-recorded:
+		case <-time.After(time.Second):
+			t.Fatal("AddCounter blocked while WriteTo was writing")
+		}
+
+		close(writer.release)
+		<-done
+		assert.Equal(t, "# TYPE requests counter\nrequests 1\n", writer.String())
+	})
+}
+
+// blockingWriter là io.Writer chặn lần ghi đầu tiên cho tới khi release được đóng.
+type blockingWriter struct {
+	bytes.Buffer
+	started chan struct{}
+	release chan struct{}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	if w.Len() == 0 {
+		close(w.started)
+		<-w.release
+	}
+	return w.Buffer.Write(p)
+}
+
+// TestApplication_Metrics tests instrumentation of lifecycle and container resolution
+func TestApplication_Metrics(t *testing.T) {
+	// exposition trả về metrics dạng text.
+	exposition := func(t *testing.T, metrics *core.PrometheusMetrics) string {
+		var out bytes.Buffer
+		_, err := metrics.WriteTo(&out)
+		require.NoError(t, err)
+		return out.String()
+	}
+
+	t.Run("records_boot_and_lifecycle_state", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := core.NewPrometheusMetrics()
+		app := core.New(map[string]interface{}{})
+		app.SetMetrics(metrics)
+		app.Register(newBindingProvider("database", "primary"))
+		require.NoError(t, app.Boot())
+
+		text := exposition(t, metrics)
+		assert.Contains(t, text, `core_provider_register_duration_seconds_count{provider="*core_test.bindingProvider"} 1`)
+		assert.Contains(t, text, `core_provider_boot_duration_seconds_count{provider="*core_test.bindingProvider",result="success"} 1`)
+		assert.Contains(t, text, "# TYPE core_boot_duration_seconds gauge")
+		assert.Contains(t, text, `core_lifecycle_state{state="booted"} 1`)
+		assert.Contains(t, text, `core_lifecycle_state{state="booting"} 0`)
+	})
+
+	t.Run("records_resolutions_and_failures", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := core.NewPrometheusMetrics()
+		app := core.New(map[string]interface{}{})
+		app.SetMetrics(metrics)
+		app.Instance("cache", "redis")
+
+		app.MustMake("cache")
+		_, err := app.Make("cache")
+		require.NoError(t, err)
+		_, err = app.Make("missing")
+		require.Error(t, err)
+		assert.Panics(t, func() { app.MustMake("missing") })
+
+		text := exposition(t, metrics)
+		assert.Contains(t, text, `core_resolutions_total{abstract="cache"} 2`)
+		assert.Contains(t, text, `core_resolution_duration_seconds_count{abstract="cache"} 2`)
+		assert.Contains(t, text, `core_resolutions_total{abstract="missing"} 2`)
+		assert.Contains(t, text, `core_resolution_failures_total{abstract="missing"} 2`)
+		assert.NotContains(t, text, `core_resolution_failures_total{abstract="cache"}`)
+	})
+
+	t.Run("serves_metrics_on_admin_handler", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		handler := core.NewAdminHandler(app)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		assert.Equal(t, http.StatusNotFound, recorder.Code)
+
+		app.SetMetrics(core.NewPrometheusMetrics())
+		recorder = httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Contains(t, recorder.Header().Get("Content-Type"), "text/plain")
+		assert.Contains(t, recorder.Body.String(), `core_lifecycle_state{state="created"} 1`)
+	})
+}
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	config "go.fork.vn/config"
 	core "go.fork.vn/core"
 
@@ -60,6 +62,53 @@ func (_c *MockApplication_Alias_Call) RunAndReturn(run func(string, string)) *Mo
 	return _c
 }
 
+// AuditReport provides a mock function with no fields
+func (_m *MockApplication) AuditReport() *core.AuditReport {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditReport")
+	}
+
+	var r0 *core.AuditReport
+	if rf, ok := ret.Get(0).(func() *core.AuditReport); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.AuditReport)
+		}
+	}
+
+	return r0
+}
+
+// MockApplication_AuditReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditReport'
+type MockApplication_AuditReport_Call struct {
+	*mock.Call
+}
+
+// AuditReport is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) AuditReport() *MockApplication_AuditReport_Call {
+	return &MockApplication_AuditReport_Call{Call: _e.mock.On("AuditReport")}
+}
+
+func (_c *MockApplication_AuditReport_Call) Run(run func()) *MockApplication_AuditReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_AuditReport_Call) Return(_a0 *core.AuditReport) *MockApplication_AuditReport_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_AuditReport_Call) RunAndReturn(run func() *core.AuditReport) *MockApplication_AuditReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Bind provides a mock function with given fields: abstract, concrete
 func (_m *MockApplication) Bind(abstract string, concrete di.BindingFunc) {
 	_m.Called(abstract, concrete)
@@ -139,17 +188,17 @@ func (_c *MockApplication_Boot_Call) RunAndReturn(run func() error) *MockApplica
 	return _c
 }
 
-// BootServiceProviders provides a mock function with no fields
-func (_m *MockApplication) BootServiceProviders() error {
-	ret := _m.Called()
+// BootContext provides a mock function with given fields: ctx
+func (_m *MockApplication) BootContext(ctx context.Context) error {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BootServiceProviders")
+		panic("no return value specified for BootContext")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -157,584 +206,1786 @@ func (_m *MockApplication) BootServiceProviders() error {
 	return r0
 }
 
-// MockApplication_BootServiceProviders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BootServiceProviders'
-type MockApplication_BootServiceProviders_Call struct {
+// MockApplication_BootContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BootContext'
+type MockApplication_BootContext_Call struct {
 	*mock.Call
 }
 
-// BootServiceProviders is a helper method to define mock.On call
-func (_e *MockApplication_Expecter) BootServiceProviders() *MockApplication_BootServiceProviders_Call {
-	return &MockApplication_BootServiceProviders_Call{Call: _e.mock.On("BootServiceProviders")}
+// BootContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockApplication_Expecter) BootContext(ctx interface{}) *MockApplication_BootContext_Call {
+	return &MockApplication_BootContext_Call{Call: _e.mock.On("BootContext", ctx)}
 }
 
-func (_c *MockApplication_BootServiceProviders_Call) Run(run func()) *MockApplication_BootServiceProviders_Call {
+func (_c *MockApplication_BootContext_Call) Run(run func(ctx context.Context)) *MockApplication_BootContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockApplication_BootServiceProviders_Call) Return(_a0 error) *MockApplication_BootServiceProviders_Call {
+func (_c *MockApplication_BootContext_Call) Return(_a0 error) *MockApplication_BootContext_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockApplication_BootServiceProviders_Call) RunAndReturn(run func() error) *MockApplication_BootServiceProviders_Call {
+func (_c *MockApplication_BootContext_Call) RunAndReturn(run func(context.Context) error) *MockApplication_BootContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Call provides a mock function with given fields: callback, additionalParams
-func (_m *MockApplication) Call(callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
-	var _ca []interface{}
-	_ca = append(_ca, callback)
-	_ca = append(_ca, additionalParams...)
-	ret := _m.Called(_ca...)
+// BootReport provides a mock function with no fields
+func (_m *MockApplication) BootReport() *core.BootReport {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Call")
+		panic("no return value specified for BootReport")
 	}
 
-	var r0 []interface{}
-	var r1 error
-	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) ([]interface{}, error)); ok {
-		return rf(callback, additionalParams...)
-	}
-	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) []interface{}); ok {
-		r0 = rf(callback, additionalParams...)
+	var r0 *core.BootReport
+	if rf, ok := ret.Get(0).(func() *core.BootReport); ok {
+		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]interface{})
+			r0 = ret.Get(0).(*core.BootReport)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(interface{}, ...interface{}) error); ok {
-		r1 = rf(callback, additionalParams...)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
+	return r0
 }
 
-// MockApplication_Call_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Call'
-type MockApplication_Call_Call struct {
+// MockApplication_BootReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BootReport'
+type MockApplication_BootReport_Call struct {
 	*mock.Call
 }
 
-// Call is a helper method to define mock.On call
-//   - callback interface{}
-//   - additionalParams ...interface{}
-func (_e *MockApplication_Expecter) Call(callback interface{}, additionalParams ...interface{}) *MockApplication_Call_Call {
-	return &MockApplication_Call_Call{Call: _e.mock.On("Call",
-		append([]interface{}{callback}, additionalParams...)...)}
+// BootReport is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) BootReport() *MockApplication_BootReport_Call {
+	return &MockApplication_BootReport_Call{Call: _e.mock.On("BootReport")}
 }
 
-func (_c *MockApplication_Call_Call) Run(run func(callback interface{}, additionalParams ...interface{})) *MockApplication_Call_Call {
+func (_c *MockApplication_BootReport_Call) Run(run func()) *MockApplication_BootReport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]interface{}, len(args)-1)
-		for i, a := range args[1:] {
-			if a != nil {
-				variadicArgs[i] = a.(interface{})
-			}
-		}
-		run(args[0].(interface{}), variadicArgs...)
+		run()
 	})
 	return _c
 }
 
-func (_c *MockApplication_Call_Call) Return(_a0 []interface{}, _a1 error) *MockApplication_Call_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockApplication_BootReport_Call) Return(_a0 *core.BootReport) *MockApplication_BootReport_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockApplication_Call_Call) RunAndReturn(run func(interface{}, ...interface{}) ([]interface{}, error)) *MockApplication_Call_Call {
+func (_c *MockApplication_BootReport_Call) RunAndReturn(run func() *core.BootReport) *MockApplication_BootReport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Config provides a mock function with no fields
-func (_m *MockApplication) Config() config.Manager {
+// BootServiceProviders provides a mock function with no fields
+func (_m *MockApplication) BootServiceProviders() error {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for Config")
+		panic("no return value specified for BootServiceProviders")
 	}
 
-	var r0 config.Manager
-	if rf, ok := ret.Get(0).(func() config.Manager); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(config.Manager)
-		}
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockApplication_Config_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Config'
-type MockApplication_Config_Call struct {
+// MockApplication_BootServiceProviders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BootServiceProviders'
+type MockApplication_BootServiceProviders_Call struct {
 	*mock.Call
 }
 
-// Config is a helper method to define mock.On call
-func (_e *MockApplication_Expecter) Config() *MockApplication_Config_Call {
-	return &MockApplication_Config_Call{Call: _e.mock.On("Config")}
+// BootServiceProviders is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) BootServiceProviders() *MockApplication_BootServiceProviders_Call {
+	return &MockApplication_BootServiceProviders_Call{Call: _e.mock.On("BootServiceProviders")}
 }
 
-func (_c *MockApplication_Config_Call) Run(run func()) *MockApplication_Config_Call {
+func (_c *MockApplication_BootServiceProviders_Call) Run(run func()) *MockApplication_BootServiceProviders_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockApplication_Config_Call) Return(_a0 config.Manager) *MockApplication_Config_Call {
+func (_c *MockApplication_BootServiceProviders_Call) Return(_a0 error) *MockApplication_BootServiceProviders_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockApplication_Config_Call) RunAndReturn(run func() config.Manager) *MockApplication_Config_Call {
+func (_c *MockApplication_BootServiceProviders_Call) RunAndReturn(run func() error) *MockApplication_BootServiceProviders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Container provides a mock function with no fields
-func (_m *MockApplication) Container() di.Container {
-	ret := _m.Called()
+// BootServiceProvidersContext provides a mock function with given fields: ctx
+func (_m *MockApplication) BootServiceProvidersContext(ctx context.Context) error {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Container")
+		panic("no return value specified for BootServiceProvidersContext")
 	}
 
-	var r0 di.Container
-	if rf, ok := ret.Get(0).(func() di.Container); ok {
-		r0 = rf()
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(di.Container)
-		}
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockApplication_Container_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Container'
-type MockApplication_Container_Call struct {
+// MockApplication_BootServiceProvidersContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BootServiceProvidersContext'
+type MockApplication_BootServiceProvidersContext_Call struct {
 	*mock.Call
 }
 
-// Container is a helper method to define mock.On call
-func (_e *MockApplication_Expecter) Container() *MockApplication_Container_Call {
-	return &MockApplication_Container_Call{Call: _e.mock.On("Container")}
+// BootServiceProvidersContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockApplication_Expecter) BootServiceProvidersContext(ctx interface{}) *MockApplication_BootServiceProvidersContext_Call {
+	return &MockApplication_BootServiceProvidersContext_Call{Call: _e.mock.On("BootServiceProvidersContext", ctx)}
 }
 
-func (_c *MockApplication_Container_Call) Run(run func()) *MockApplication_Container_Call {
+func (_c *MockApplication_BootServiceProvidersContext_Call) Run(run func(ctx context.Context)) *MockApplication_BootServiceProvidersContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockApplication_Container_Call) Return(_a0 di.Container) *MockApplication_Container_Call {
+func (_c *MockApplication_BootServiceProvidersContext_Call) Return(_a0 error) *MockApplication_BootServiceProvidersContext_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockApplication_Container_Call) RunAndReturn(run func() di.Container) *MockApplication_Container_Call {
+func (_c *MockApplication_BootServiceProvidersContext_Call) RunAndReturn(run func(context.Context) error) *MockApplication_BootServiceProvidersContext_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Instance provides a mock function with given fields: abstract, instance
-func (_m *MockApplication) Instance(abstract string, instance interface{}) {
-	_m.Called(abstract, instance)
+// BootServicesContext provides a mock function with given fields: ctx, services
+func (_m *MockApplication) BootServicesContext(ctx context.Context, services ...string) error {
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_va := make([]interface{}, len(services))
+	for _i := range services {
+		_va[_i] = services[_i]
+	}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BootServicesContext")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...string) error); ok {
+		r0 = rf(ctx, services...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
-// MockApplication_Instance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Instance'
-type MockApplication_Instance_Call struct {
+// MockApplication_BootServicesContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BootServicesContext'
+type MockApplication_BootServicesContext_Call struct {
 	*mock.Call
 }
 
-// Instance is a helper method to define mock.On call
-//   - abstract string
-//   - instance interface{}
-func (_e *MockApplication_Expecter) Instance(abstract interface{}, instance interface{}) *MockApplication_Instance_Call {
-	return &MockApplication_Instance_Call{Call: _e.mock.On("Instance", abstract, instance)}
+// BootServicesContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - services ...string
+func (_e *MockApplication_Expecter) BootServicesContext(ctx interface{}, services ...interface{}) *MockApplication_BootServicesContext_Call {
+	return &MockApplication_BootServicesContext_Call{Call: _e.mock.On("BootServicesContext",
+		append([]interface{}{ctx}, services...)...)}
 }
 
-func (_c *MockApplication_Instance_Call) Run(run func(abstract string, instance interface{})) *MockApplication_Instance_Call {
+func (_c *MockApplication_BootServicesContext_Call) Run(run func(ctx context.Context, services ...string)) *MockApplication_BootServicesContext_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(interface{}))
+		variadicArgs := make([]string, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockApplication_Instance_Call) Return() *MockApplication_Instance_Call {
-	_c.Call.Return()
+func (_c *MockApplication_BootServicesContext_Call) Return(_a0 error) *MockApplication_BootServicesContext_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockApplication_Instance_Call) RunAndReturn(run func(string, interface{})) *MockApplication_Instance_Call {
-	_c.Run(run)
+func (_c *MockApplication_BootServicesContext_Call) RunAndReturn(run func(context.Context, ...string) error) *MockApplication_BootServicesContext_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// Log provides a mock function with no fields
-func (_m *MockApplication) Log() log.Manager {
-	ret := _m.Called()
+// Call provides a mock function with given fields: callback, additionalParams
+func (_m *MockApplication) Call(callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
+	var _ca []interface{}
+	_ca = append(_ca, callback)
+	_ca = append(_ca, additionalParams...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Log")
+		panic("no return value specified for Call")
 	}
 
-	var r0 log.Manager
-	if rf, ok := ret.Get(0).(func() log.Manager); ok {
-		r0 = rf()
+	var r0 []interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) ([]interface{}, error)); ok {
+		return rf(callback, additionalParams...)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) []interface{}); ok {
+		r0 = rf(callback, additionalParams...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(log.Manager)
+			r0 = ret.Get(0).([]interface{})
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(interface{}, ...interface{}) error); ok {
+		r1 = rf(callback, additionalParams...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockApplication_Log_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Log'
-type MockApplication_Log_Call struct {
+// MockApplication_Call_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Call'
+type MockApplication_Call_Call struct {
 	*mock.Call
 }
 
-// Log is a helper method to define mock.On call
-func (_e *MockApplication_Expecter) Log() *MockApplication_Log_Call {
-	return &MockApplication_Log_Call{Call: _e.mock.On("Log")}
+// Call is a helper method to define mock.On call
+//   - callback interface{}
+//   - additionalParams ...interface{}
+func (_e *MockApplication_Expecter) Call(callback interface{}, additionalParams ...interface{}) *MockApplication_Call_Call {
+	return &MockApplication_Call_Call{Call: _e.mock.On("Call",
+		append([]interface{}{callback}, additionalParams...)...)}
 }
 
-func (_c *MockApplication_Log_Call) Run(run func()) *MockApplication_Log_Call {
+func (_c *MockApplication_Call_Call) Run(run func(callback interface{}, additionalParams ...interface{})) *MockApplication_Call_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockApplication_Log_Call) Return(_a0 log.Manager) *MockApplication_Log_Call {
-	_c.Call.Return(_a0)
+func (_c *MockApplication_Call_Call) Return(_a0 []interface{}, _a1 error) *MockApplication_Call_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockApplication_Log_Call) RunAndReturn(run func() log.Manager) *MockApplication_Log_Call {
+func (_c *MockApplication_Call_Call) RunAndReturn(run func(interface{}, ...interface{}) ([]interface{}, error)) *MockApplication_Call_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Make provides a mock function with given fields: abstract
-func (_m *MockApplication) Make(abstract string) (interface{}, error) {
-	ret := _m.Called(abstract)
+// CallContext provides a mock function with given fields: ctx, callback, additionalParams
+func (_m *MockApplication) CallContext(ctx context.Context, callback interface{}, additionalParams ...interface{}) ([]interface{}, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, callback)
+	_ca = append(_ca, additionalParams...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Make")
+		panic("no return value specified for CallContext")
 	}
 
-	var r0 interface{}
+	var r0 []interface{}
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
-		return rf(abstract)
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, ...interface{}) ([]interface{}, error)); ok {
+		return rf(ctx, callback, additionalParams...)
 	}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(abstract)
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, ...interface{}) []interface{}); ok {
+		r0 = rf(ctx, callback, additionalParams...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, ...interface{}) error); ok {
+		r1 = rf(ctx, callback, additionalParams...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockApplication_CallContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CallContext'
+type MockApplication_CallContext_Call struct {
+	*mock.Call
+}
+
+// CallContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - callback interface{}
+//   - additionalParams ...interface{}
+func (_e *MockApplication_Expecter) CallContext(ctx interface{}, callback interface{}, additionalParams ...interface{}) *MockApplication_CallContext_Call {
+	return &MockApplication_CallContext_Call{Call: _e.mock.On("CallContext",
+		append([]interface{}{ctx, callback}, additionalParams...)...)}
+}
+
+func (_c *MockApplication_CallContext_Call) Run(run func(ctx context.Context, callback interface{}, additionalParams ...interface{})) *MockApplication_CallContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockApplication_CallContext_Call) Return(_a0 []interface{}, _a1 error) *MockApplication_CallContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockApplication_CallContext_Call) RunAndReturn(run func(context.Context, interface{}, ...interface{}) ([]interface{}, error)) *MockApplication_CallContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CallSafe provides a mock function with given fields: callback, params
+func (_m *MockApplication) CallSafe(callback interface{}, params ...interface{}) ([]interface{}, error) {
+	var _ca []interface{}
+	_ca = append(_ca, callback)
+	_ca = append(_ca, params...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallSafe")
+	}
+
+	var r0 []interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) ([]interface{}, error)); ok {
+		return rf(callback, params...)
+	}
+	if rf, ok := ret.Get(0).(func(interface{}, ...interface{}) []interface{}); ok {
+		r0 = rf(callback, params...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(interface{}, ...interface{}) error); ok {
+		r1 = rf(callback, params...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockApplication_CallSafe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CallSafe'
+type MockApplication_CallSafe_Call struct {
+	*mock.Call
+}
+
+// CallSafe is a helper method to define mock.On call
+//   - callback interface{}
+//   - params ...interface{}
+func (_e *MockApplication_Expecter) CallSafe(callback interface{}, params ...interface{}) *MockApplication_CallSafe_Call {
+	return &MockApplication_CallSafe_Call{Call: _e.mock.On("CallSafe",
+		append([]interface{}{callback}, params...)...)}
+}
+
+func (_c *MockApplication_CallSafe_Call) Run(run func(callback interface{}, params ...interface{})) *MockApplication_CallSafe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockApplication_CallSafe_Call) Return(_a0 []interface{}, _a1 error) *MockApplication_CallSafe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockApplication_CallSafe_Call) RunAndReturn(run func(interface{}, ...interface{}) ([]interface{}, error)) *MockApplication_CallSafe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CallSafeContext provides a mock function with given fields: ctx, callback, params
+func (_m *MockApplication) CallSafeContext(ctx context.Context, callback interface{}, params ...interface{}) ([]interface{}, error) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, callback)
+	_ca = append(_ca, params...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CallSafeContext")
+	}
+
+	var r0 []interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, ...interface{}) ([]interface{}, error)); ok {
+		return rf(ctx, callback, params...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, interface{}, ...interface{}) []interface{}); ok {
+		r0 = rf(ctx, callback, params...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, interface{}, ...interface{}) error); ok {
+		r1 = rf(ctx, callback, params...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockApplication_CallSafeContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CallSafeContext'
+type MockApplication_CallSafeContext_Call struct {
+	*mock.Call
+}
+
+// CallSafeContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - callback interface{}
+//   - params ...interface{}
+func (_e *MockApplication_Expecter) CallSafeContext(ctx interface{}, callback interface{}, params ...interface{}) *MockApplication_CallSafeContext_Call {
+	return &MockApplication_CallSafeContext_Call{Call: _e.mock.On("CallSafeContext",
+		append([]interface{}{ctx, callback}, params...)...)}
+}
+
+func (_c *MockApplication_CallSafeContext_Call) Run(run func(ctx context.Context, callback interface{}, params ...interface{})) *MockApplication_CallSafeContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(context.Context), args[1].(interface{}), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockApplication_CallSafeContext_Call) Return(_a0 []interface{}, _a1 error) *MockApplication_CallSafeContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockApplication_CallSafeContext_Call) RunAndReturn(run func(context.Context, interface{}, ...interface{}) ([]interface{}, error)) *MockApplication_CallSafeContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Config provides a mock function with no fields
+func (_m *MockApplication) Config() config.Manager {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Config")
+	}
+
+	var r0 config.Manager
+	if rf, ok := ret.Get(0).(func() config.Manager); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Manager)
+		}
+	}
+
+	return r0
+}
+
+// MockApplication_Config_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Config'
+type MockApplication_Config_Call struct {
+	*mock.Call
+}
+
+// Config is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) Config() *MockApplication_Config_Call {
+	return &MockApplication_Config_Call{Call: _e.mock.On("Config")}
+}
+
+func (_c *MockApplication_Config_Call) Run(run func()) *MockApplication_Config_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_Config_Call) Return(_a0 config.Manager) *MockApplication_Config_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_Config_Call) RunAndReturn(run func() config.Manager) *MockApplication_Config_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Container provides a mock function with no fields
+func (_m *MockApplication) Container() di.Container {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Container")
+	}
+
+	var r0 di.Container
+	if rf, ok := ret.Get(0).(func() di.Container); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(di.Container)
+		}
+	}
+
+	return r0
+}
+
+// MockApplication_Container_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Container'
+type MockApplication_Container_Call struct {
+	*mock.Call
+}
+
+// Container is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) Container() *MockApplication_Container_Call {
+	return &MockApplication_Container_Call{Call: _e.mock.On("Container")}
+}
+
+func (_c *MockApplication_Container_Call) Run(run func()) *MockApplication_Container_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_Container_Call) Return(_a0 di.Container) *MockApplication_Container_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_Container_Call) RunAndReturn(run func() di.Container) *MockApplication_Container_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableAudit provides a mock function with no fields
+func (_m *MockApplication) EnableAudit() {
+	_m.Called()
+}
+
+// MockApplication_EnableAudit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableAudit'
+type MockApplication_EnableAudit_Call struct {
+	*mock.Call
+}
+
+// EnableAudit is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) EnableAudit() *MockApplication_EnableAudit_Call {
+	return &MockApplication_EnableAudit_Call{Call: _e.mock.On("EnableAudit")}
+}
+
+func (_c *MockApplication_EnableAudit_Call) Run(run func()) *MockApplication_EnableAudit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_EnableAudit_Call) Return() *MockApplication_EnableAudit_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockApplication_EnableAudit_Call) RunAndReturn(run func()) *MockApplication_EnableAudit_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Health provides a mock function with given fields: ctx
+func (_m *MockApplication) Health(ctx context.Context) *core.HealthReport {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Health")
+	}
+
+	var r0 *core.HealthReport
+	if rf, ok := ret.Get(0).(func(context.Context) *core.HealthReport); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.HealthReport)
+		}
+	}
+
+	return r0
+}
+
+// MockApplication_Health_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Health'
+type MockApplication_Health_Call struct {
+	*mock.Call
+}
+
+// Health is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockApplication_Expecter) Health(ctx interface{}) *MockApplication_Health_Call {
+	return &MockApplication_Health_Call{Call: _e.mock.On("Health", ctx)}
+}
+
+func (_c *MockApplication_Health_Call) Run(run func(ctx context.Context)) *MockApplication_Health_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockApplication_Health_Call) Return(_a0 *core.HealthReport) *MockApplication_Health_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_Health_Call) RunAndReturn(run func(context.Context) *core.HealthReport) *MockApplication_Health_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Instance provides a mock function with given fields: abstract, instance
+func (_m *MockApplication) Instance(abstract string, instance interface{}) {
+	_m.Called(abstract, instance)
+}
+
+// MockApplication_Instance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Instance'
+type MockApplication_Instance_Call struct {
+	*mock.Call
+}
+
+// Instance is a helper method to define mock.On call
+//   - abstract string
+//   - instance interface{}
+func (_e *MockApplication_Expecter) Instance(abstract interface{}, instance interface{}) *MockApplication_Instance_Call {
+	return &MockApplication_Instance_Call{Call: _e.mock.On("Instance", abstract, instance)}
+}
+
+func (_c *MockApplication_Instance_Call) Run(run func(abstract string, instance interface{})) *MockApplication_Instance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockApplication_Instance_Call) Return() *MockApplication_Instance_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockApplication_Instance_Call) RunAndReturn(run func(string, interface{})) *MockApplication_Instance_Call {
+	_c.Run(run)
+	return _c
+}
+
+// LoadProviders provides a mock function with given fields: ctx, providers
+func (_m *MockApplication) LoadProviders(ctx context.Context, providers ...di.ServiceProvider) error {
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_va := make([]interface{}, len(providers))
+	for _i := range providers {
+		_va[_i] = providers[_i]
+	}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadProviders")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...di.ServiceProvider) error); ok {
+		r0 = rf(ctx, providers...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockApplication_LoadProviders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadProviders'
+type MockApplication_LoadProviders_Call struct {
+	*mock.Call
+}
+
+// LoadProviders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - providers ...di.ServiceProvider
+func (_e *MockApplication_Expecter) LoadProviders(ctx interface{}, providers ...interface{}) *MockApplication_LoadProviders_Call {
+	return &MockApplication_LoadProviders_Call{Call: _e.mock.On("LoadProviders",
+		append([]interface{}{ctx}, providers...)...)}
+}
+
+func (_c *MockApplication_LoadProviders_Call) Run(run func(ctx context.Context, providers ...di.ServiceProvider)) *MockApplication_LoadProviders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]di.ServiceProvider, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(di.ServiceProvider)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockApplication_LoadProviders_Call) Return(_a0 error) *MockApplication_LoadProviders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_LoadProviders_Call) RunAndReturn(run func(context.Context, ...di.ServiceProvider) error) *MockApplication_LoadProviders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Log provides a mock function with no fields
+func (_m *MockApplication) Log() log.Manager {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Log")
+	}
+
+	var r0 log.Manager
+	if rf, ok := ret.Get(0).(func() log.Manager); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(log.Manager)
+		}
+	}
+
+	return r0
+}
+
+// MockApplication_Log_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Log'
+type MockApplication_Log_Call struct {
+	*mock.Call
+}
+
+// Log is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) Log() *MockApplication_Log_Call {
+	return &MockApplication_Log_Call{Call: _e.mock.On("Log")}
+}
+
+func (_c *MockApplication_Log_Call) Run(run func()) *MockApplication_Log_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_Log_Call) Return(_a0 log.Manager) *MockApplication_Log_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_Log_Call) RunAndReturn(run func() log.Manager) *MockApplication_Log_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Make provides a mock function with given fields: abstract
+func (_m *MockApplication) Make(abstract string) (interface{}, error) {
+	ret := _m.Called(abstract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Make")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (interface{}, error)); ok {
+		return rf(abstract)
+	}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(abstract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(abstract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockApplication_Make_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Make'
+type MockApplication_Make_Call struct {
+	*mock.Call
+}
+
+// Make is a helper method to define mock.On call
+//   - abstract string
+func (_e *MockApplication_Expecter) Make(abstract interface{}) *MockApplication_Make_Call {
+	return &MockApplication_Make_Call{Call: _e.mock.On("Make", abstract)}
+}
+
+func (_c *MockApplication_Make_Call) Run(run func(abstract string)) *MockApplication_Make_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockApplication_Make_Call) Return(_a0 interface{}, _a1 error) *MockApplication_Make_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockApplication_Make_Call) RunAndReturn(run func(string) (interface{}, error)) *MockApplication_Make_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MakeContext provides a mock function with given fields: ctx, abstract
+func (_m *MockApplication) MakeContext(ctx context.Context, abstract string) (interface{}, error) {
+	ret := _m.Called(ctx, abstract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MakeContext")
+	}
+
+	var r0 interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (interface{}, error)); ok {
+		return rf(ctx, abstract)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) interface{}); ok {
+		r0 = rf(ctx, abstract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, abstract)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockApplication_MakeContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MakeContext'
+type MockApplication_MakeContext_Call struct {
+	*mock.Call
+}
+
+// MakeContext is a helper method to define mock.On call
+//   - ctx context.Context
+//   - abstract string
+func (_e *MockApplication_Expecter) MakeContext(ctx interface{}, abstract interface{}) *MockApplication_MakeContext_Call {
+	return &MockApplication_MakeContext_Call{Call: _e.mock.On("MakeContext", ctx, abstract)}
+}
+
+func (_c *MockApplication_MakeContext_Call) Run(run func(ctx context.Context, abstract string)) *MockApplication_MakeContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockApplication_MakeContext_Call) Return(_a0 interface{}, _a1 error) *MockApplication_MakeContext_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockApplication_MakeContext_Call) RunAndReturn(run func(context.Context, string) (interface{}, error)) *MockApplication_MakeContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Metrics provides a mock function with no fields
+func (_m *MockApplication) Metrics() core.Metrics {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Metrics")
+	}
+
+	var r0 core.Metrics
+	if rf, ok := ret.Get(0).(func() core.Metrics); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Metrics)
+		}
+	}
+
+	return r0
+}
+
+// MockApplication_Metrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Metrics'
+type MockApplication_Metrics_Call struct {
+	*mock.Call
+}
+
+// Metrics is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) Metrics() *MockApplication_Metrics_Call {
+	return &MockApplication_Metrics_Call{Call: _e.mock.On("Metrics")}
+}
+
+func (_c *MockApplication_Metrics_Call) Run(run func()) *MockApplication_Metrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_Metrics_Call) Return(_a0 core.Metrics) *MockApplication_Metrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_Metrics_Call) RunAndReturn(run func() core.Metrics) *MockApplication_Metrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ModuleLoader provides a mock function with no fields
+func (_m *MockApplication) ModuleLoader() core.ModuleLoaderContract {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ModuleLoader")
+	}
+
+	var r0 core.ModuleLoaderContract
+	if rf, ok := ret.Get(0).(func() core.ModuleLoaderContract); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.ModuleLoaderContract)
+		}
+	}
+
+	return r0
+}
+
+// MockApplication_ModuleLoader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ModuleLoader'
+type MockApplication_ModuleLoader_Call struct {
+	*mock.Call
+}
+
+// ModuleLoader is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) ModuleLoader() *MockApplication_ModuleLoader_Call {
+	return &MockApplication_ModuleLoader_Call{Call: _e.mock.On("ModuleLoader")}
+}
+
+func (_c *MockApplication_ModuleLoader_Call) Run(run func()) *MockApplication_ModuleLoader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_ModuleLoader_Call) Return(_a0 core.ModuleLoaderContract) *MockApplication_ModuleLoader_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_ModuleLoader_Call) RunAndReturn(run func() core.ModuleLoaderContract) *MockApplication_ModuleLoader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Modules provides a mock function with no fields
+func (_m *MockApplication) Modules() []core.ModuleManifest {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Modules")
+	}
+
+	var r0 []core.ModuleManifest
+	if rf, ok := ret.Get(0).(func() []core.ModuleManifest); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]core.ModuleManifest)
+		}
+	}
+
+	return r0
+}
+
+// MockApplication_Modules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Modules'
+type MockApplication_Modules_Call struct {
+	*mock.Call
+}
+
+// Modules is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) Modules() *MockApplication_Modules_Call {
+	return &MockApplication_Modules_Call{Call: _e.mock.On("Modules")}
+}
+
+func (_c *MockApplication_Modules_Call) Run(run func()) *MockApplication_Modules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_Modules_Call) Return(_a0 []core.ModuleManifest) *MockApplication_Modules_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_Modules_Call) RunAndReturn(run func() []core.ModuleManifest) *MockApplication_Modules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MustMake provides a mock function with given fields: abstract
+func (_m *MockApplication) MustMake(abstract string) interface{} {
+	ret := _m.Called(abstract)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MustMake")
+	}
+
+	var r0 interface{}
+	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
+		r0 = rf(abstract)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(interface{})
+		}
+	}
+
+	return r0
+}
+
+// MockApplication_MustMake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MustMake'
+type MockApplication_MustMake_Call struct {
+	*mock.Call
+}
+
+// MustMake is a helper method to define mock.On call
+//   - abstract string
+func (_e *MockApplication_Expecter) MustMake(abstract interface{}) *MockApplication_MustMake_Call {
+	return &MockApplication_MustMake_Call{Call: _e.mock.On("MustMake", abstract)}
+}
+
+func (_c *MockApplication_MustMake_Call) Run(run func(abstract string)) *MockApplication_MustMake_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockApplication_MustMake_Call) Return(_a0 interface{}) *MockApplication_MustMake_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_MustMake_Call) RunAndReturn(run func(string) interface{}) *MockApplication_MustMake_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Plan provides a mock function with no fields
+func (_m *MockApplication) Plan() *core.Plan {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Plan")
+	}
+
+	var r0 *core.Plan
+	if rf, ok := ret.Get(0).(func() *core.Plan); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.Plan)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(abstract)
+	return r0
+}
+
+// MockApplication_Plan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Plan'
+type MockApplication_Plan_Call struct {
+	*mock.Call
+}
+
+// Plan is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) Plan() *MockApplication_Plan_Call {
+	return &MockApplication_Plan_Call{Call: _e.mock.On("Plan")}
+}
+
+func (_c *MockApplication_Plan_Call) Run(run func()) *MockApplication_Plan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_Plan_Call) Return(_a0 *core.Plan) *MockApplication_Plan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_Plan_Call) RunAndReturn(run func() *core.Plan) *MockApplication_Plan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: provider
+func (_m *MockApplication) Register(provider di.ServiceProvider) {
+	_m.Called(provider)
+}
+
+// MockApplication_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type MockApplication_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - provider di.ServiceProvider
+func (_e *MockApplication_Expecter) Register(provider interface{}) *MockApplication_Register_Call {
+	return &MockApplication_Register_Call{Call: _e.mock.On("Register", provider)}
+}
+
+func (_c *MockApplication_Register_Call) Run(run func(provider di.ServiceProvider)) *MockApplication_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(di.ServiceProvider))
+	})
+	return _c
+}
+
+func (_c *MockApplication_Register_Call) Return() *MockApplication_Register_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockApplication_Register_Call) RunAndReturn(run func(di.ServiceProvider)) *MockApplication_Register_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RegisterServiceProviders provides a mock function with no fields
+func (_m *MockApplication) RegisterServiceProviders() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterServiceProviders")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockApplication_RegisterServiceProviders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterServiceProviders'
+type MockApplication_RegisterServiceProviders_Call struct {
+	*mock.Call
+}
+
+// RegisterServiceProviders is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) RegisterServiceProviders() *MockApplication_RegisterServiceProviders_Call {
+	return &MockApplication_RegisterServiceProviders_Call{Call: _e.mock.On("RegisterServiceProviders")}
+}
+
+func (_c *MockApplication_RegisterServiceProviders_Call) Run(run func()) *MockApplication_RegisterServiceProviders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_RegisterServiceProviders_Call) Return(_a0 error) *MockApplication_RegisterServiceProviders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_RegisterServiceProviders_Call) RunAndReturn(run func() error) *MockApplication_RegisterServiceProviders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterWithDependencies provides a mock function with no fields
+func (_m *MockApplication) RegisterWithDependencies() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterWithDependencies")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockApplication_RegisterWithDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterWithDependencies'
+type MockApplication_RegisterWithDependencies_Call struct {
+	*mock.Call
+}
+
+// RegisterWithDependencies is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) RegisterWithDependencies() *MockApplication_RegisterWithDependencies_Call {
+	return &MockApplication_RegisterWithDependencies_Call{Call: _e.mock.On("RegisterWithDependencies")}
+}
+
+func (_c *MockApplication_RegisterWithDependencies_Call) Run(run func()) *MockApplication_RegisterWithDependencies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_RegisterWithDependencies_Call) Return(_a0 error) *MockApplication_RegisterWithDependencies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_RegisterWithDependencies_Call) RunAndReturn(run func() error) *MockApplication_RegisterWithDependencies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplaceProvider provides a mock function with given fields: ctx, old, replacement
+func (_m *MockApplication) ReplaceProvider(ctx context.Context, old di.ServiceProvider, replacement di.ServiceProvider) error {
+	ret := _m.Called(ctx, old, replacement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceProvider")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, di.ServiceProvider, di.ServiceProvider) error); ok {
+		r0 = rf(ctx, old, replacement)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockApplication_Make_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Make'
-type MockApplication_Make_Call struct {
+// MockApplication_ReplaceProvider_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceProvider'
+type MockApplication_ReplaceProvider_Call struct {
 	*mock.Call
 }
 
-// Make is a helper method to define mock.On call
-//   - abstract string
-func (_e *MockApplication_Expecter) Make(abstract interface{}) *MockApplication_Make_Call {
-	return &MockApplication_Make_Call{Call: _e.mock.On("Make", abstract)}
+// ReplaceProvider is a helper method to define mock.On call
+//   - ctx context.Context
+//   - old di.ServiceProvider
+//   - replacement di.ServiceProvider
+func (_e *MockApplication_Expecter) ReplaceProvider(ctx interface{}, old interface{}, replacement interface{}) *MockApplication_ReplaceProvider_Call {
+	return &MockApplication_ReplaceProvider_Call{Call: _e.mock.On("ReplaceProvider", ctx, old, replacement)}
 }
 
-func (_c *MockApplication_Make_Call) Run(run func(abstract string)) *MockApplication_Make_Call {
+func (_c *MockApplication_ReplaceProvider_Call) Run(run func(ctx context.Context, old di.ServiceProvider, replacement di.ServiceProvider)) *MockApplication_ReplaceProvider_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(di.ServiceProvider), args[2].(di.ServiceProvider))
 	})
 	return _c
 }
 
-func (_c *MockApplication_Make_Call) Return(_a0 interface{}, _a1 error) *MockApplication_Make_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockApplication_ReplaceProvider_Call) Return(_a0 error) *MockApplication_ReplaceProvider_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockApplication_Make_Call) RunAndReturn(run func(string) (interface{}, error)) *MockApplication_Make_Call {
+func (_c *MockApplication_ReplaceProvider_Call) RunAndReturn(run func(context.Context, di.ServiceProvider, di.ServiceProvider) error) *MockApplication_ReplaceProvider_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ModuleLoader provides a mock function with no fields
-func (_m *MockApplication) ModuleLoader() core.ModuleLoaderContract {
+// ServiceProviders provides a mock function with no fields
+func (_m *MockApplication) ServiceProviders() []di.ServiceProvider {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for ModuleLoader")
+		panic("no return value specified for ServiceProviders")
 	}
 
-	var r0 core.ModuleLoaderContract
-	if rf, ok := ret.Get(0).(func() core.ModuleLoaderContract); ok {
+	var r0 []di.ServiceProvider
+	if rf, ok := ret.Get(0).(func() []di.ServiceProvider); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(core.ModuleLoaderContract)
+			r0 = ret.Get(0).([]di.ServiceProvider)
 		}
 	}
 
 	return r0
 }
 
-// MockApplication_ModuleLoader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ModuleLoader'
-type MockApplication_ModuleLoader_Call struct {
+// MockApplication_ServiceProviders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ServiceProviders'
+type MockApplication_ServiceProviders_Call struct {
 	*mock.Call
 }
 
-// ModuleLoader is a helper method to define mock.On call
-func (_e *MockApplication_Expecter) ModuleLoader() *MockApplication_ModuleLoader_Call {
-	return &MockApplication_ModuleLoader_Call{Call: _e.mock.On("ModuleLoader")}
+// ServiceProviders is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) ServiceProviders() *MockApplication_ServiceProviders_Call {
+	return &MockApplication_ServiceProviders_Call{Call: _e.mock.On("ServiceProviders")}
 }
 
-func (_c *MockApplication_ModuleLoader_Call) Run(run func()) *MockApplication_ModuleLoader_Call {
+func (_c *MockApplication_ServiceProviders_Call) Run(run func()) *MockApplication_ServiceProviders_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockApplication_ModuleLoader_Call) Return(_a0 core.ModuleLoaderContract) *MockApplication_ModuleLoader_Call {
+func (_c *MockApplication_ServiceProviders_Call) Return(_a0 []di.ServiceProvider) *MockApplication_ServiceProviders_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockApplication_ModuleLoader_Call) RunAndReturn(run func() core.ModuleLoaderContract) *MockApplication_ModuleLoader_Call {
+func (_c *MockApplication_ServiceProviders_Call) RunAndReturn(run func() []di.ServiceProvider) *MockApplication_ServiceProviders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MustMake provides a mock function with given fields: abstract
-func (_m *MockApplication) MustMake(abstract string) interface{} {
-	ret := _m.Called(abstract)
+// SetMetrics provides a mock function with given fields: metrics
+func (_m *MockApplication) SetMetrics(metrics core.Metrics) {
+	_m.Called(metrics)
+}
+
+// MockApplication_SetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMetrics'
+type MockApplication_SetMetrics_Call struct {
+	*mock.Call
+}
+
+// SetMetrics is a helper method to define mock.On call
+//   - metrics core.Metrics
+func (_e *MockApplication_Expecter) SetMetrics(metrics interface{}) *MockApplication_SetMetrics_Call {
+	return &MockApplication_SetMetrics_Call{Call: _e.mock.On("SetMetrics", metrics)}
+}
+
+func (_c *MockApplication_SetMetrics_Call) Run(run func(metrics core.Metrics)) *MockApplication_SetMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Metrics))
+	})
+	return _c
+}
+
+func (_c *MockApplication_SetMetrics_Call) Return() *MockApplication_SetMetrics_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockApplication_SetMetrics_Call) RunAndReturn(run func(core.Metrics)) *MockApplication_SetMetrics_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetTracer provides a mock function with given fields: tracer
+func (_m *MockApplication) SetTracer(tracer core.Tracer) {
+	_m.Called(tracer)
+}
+
+// MockApplication_SetTracer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTracer'
+type MockApplication_SetTracer_Call struct {
+	*mock.Call
+}
+
+// SetTracer is a helper method to define mock.On call
+//   - tracer core.Tracer
+func (_e *MockApplication_Expecter) SetTracer(tracer interface{}) *MockApplication_SetTracer_Call {
+	return &MockApplication_SetTracer_Call{Call: _e.mock.On("SetTracer", tracer)}
+}
+
+func (_c *MockApplication_SetTracer_Call) Run(run func(tracer core.Tracer)) *MockApplication_SetTracer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(core.Tracer))
+	})
+	return _c
+}
+
+func (_c *MockApplication_SetTracer_Call) Return() *MockApplication_SetTracer_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockApplication_SetTracer_Call) RunAndReturn(run func(core.Tracer)) *MockApplication_SetTracer_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Shutdown provides a mock function with given fields: ctx
+func (_m *MockApplication) Shutdown(ctx context.Context) error {
+	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for MustMake")
+		panic("no return value specified for Shutdown")
 	}
 
-	var r0 interface{}
-	if rf, ok := ret.Get(0).(func(string) interface{}); ok {
-		r0 = rf(abstract)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(interface{})
-		}
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockApplication_MustMake_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MustMake'
-type MockApplication_MustMake_Call struct {
+// MockApplication_Shutdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Shutdown'
+type MockApplication_Shutdown_Call struct {
 	*mock.Call
 }
 
-// MustMake is a helper method to define mock.On call
-//   - abstract string
-func (_e *MockApplication_Expecter) MustMake(abstract interface{}) *MockApplication_MustMake_Call {
-	return &MockApplication_MustMake_Call{Call: _e.mock.On("MustMake", abstract)}
+// Shutdown is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockApplication_Expecter) Shutdown(ctx interface{}) *MockApplication_Shutdown_Call {
+	return &MockApplication_Shutdown_Call{Call: _e.mock.On("Shutdown", ctx)}
 }
 
-func (_c *MockApplication_MustMake_Call) Run(run func(abstract string)) *MockApplication_MustMake_Call {
+func (_c *MockApplication_Shutdown_Call) Run(run func(ctx context.Context)) *MockApplication_Shutdown_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockApplication_MustMake_Call) Return(_a0 interface{}) *MockApplication_MustMake_Call {
+func (_c *MockApplication_Shutdown_Call) Return(_a0 error) *MockApplication_Shutdown_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockApplication_MustMake_Call) RunAndReturn(run func(string) interface{}) *MockApplication_MustMake_Call {
+func (_c *MockApplication_Shutdown_Call) RunAndReturn(run func(context.Context) error) *MockApplication_Shutdown_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function with given fields: provider
-func (_m *MockApplication) Register(provider di.ServiceProvider) {
-	_m.Called(provider)
+// Singleton provides a mock function with given fields: abstract, concrete
+func (_m *MockApplication) Singleton(abstract string, concrete di.BindingFunc) {
+	_m.Called(abstract, concrete)
 }
 
-// MockApplication_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
-type MockApplication_Register_Call struct {
+// MockApplication_Singleton_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Singleton'
+type MockApplication_Singleton_Call struct {
 	*mock.Call
 }
 
-// Register is a helper method to define mock.On call
-//   - provider di.ServiceProvider
-func (_e *MockApplication_Expecter) Register(provider interface{}) *MockApplication_Register_Call {
-	return &MockApplication_Register_Call{Call: _e.mock.On("Register", provider)}
+// Singleton is a helper method to define mock.On call
+//   - abstract string
+//   - concrete di.BindingFunc
+func (_e *MockApplication_Expecter) Singleton(abstract interface{}, concrete interface{}) *MockApplication_Singleton_Call {
+	return &MockApplication_Singleton_Call{Call: _e.mock.On("Singleton", abstract, concrete)}
 }
 
-func (_c *MockApplication_Register_Call) Run(run func(provider di.ServiceProvider)) *MockApplication_Register_Call {
+func (_c *MockApplication_Singleton_Call) Run(run func(abstract string, concrete di.BindingFunc)) *MockApplication_Singleton_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(di.ServiceProvider))
+		run(args[0].(string), args[1].(di.BindingFunc))
 	})
 	return _c
 }
 
-func (_c *MockApplication_Register_Call) Return() *MockApplication_Register_Call {
+func (_c *MockApplication_Singleton_Call) Return() *MockApplication_Singleton_Call {
 	_c.Call.Return()
 	return _c
 }
 
-func (_c *MockApplication_Register_Call) RunAndReturn(run func(di.ServiceProvider)) *MockApplication_Register_Call {
+func (_c *MockApplication_Singleton_Call) RunAndReturn(run func(string, di.BindingFunc)) *MockApplication_Singleton_Call {
 	_c.Run(run)
 	return _c
 }
 
-// RegisterServiceProviders provides a mock function with no fields
-func (_m *MockApplication) RegisterServiceProviders() error {
+// State provides a mock function with no fields
+func (_m *MockApplication) State() core.State {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegisterServiceProviders")
+		panic("no return value specified for State")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
+	var r0 core.State
+	if rf, ok := ret.Get(0).(func() core.State); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(core.State)
 	}
 
 	return r0
 }
 
-// MockApplication_RegisterServiceProviders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterServiceProviders'
-type MockApplication_RegisterServiceProviders_Call struct {
+// MockApplication_State_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'State'
+type MockApplication_State_Call struct {
 	*mock.Call
 }
 
-// RegisterServiceProviders is a helper method to define mock.On call
-func (_e *MockApplication_Expecter) RegisterServiceProviders() *MockApplication_RegisterServiceProviders_Call {
-	return &MockApplication_RegisterServiceProviders_Call{Call: _e.mock.On("RegisterServiceProviders")}
+// State is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) State() *MockApplication_State_Call {
+	return &MockApplication_State_Call{Call: _e.mock.On("State")}
 }
 
-func (_c *MockApplication_RegisterServiceProviders_Call) Run(run func()) *MockApplication_RegisterServiceProviders_Call {
+func (_c *MockApplication_State_Call) Run(run func()) *MockApplication_State_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockApplication_RegisterServiceProviders_Call) Return(_a0 error) *MockApplication_RegisterServiceProviders_Call {
+func (_c *MockApplication_State_Call) Return(_a0 core.State) *MockApplication_State_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockApplication_RegisterServiceProviders_Call) RunAndReturn(run func() error) *MockApplication_RegisterServiceProviders_Call {
+func (_c *MockApplication_State_Call) RunAndReturn(run func() core.State) *MockApplication_State_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegisterWithDependencies provides a mock function with no fields
-func (_m *MockApplication) RegisterWithDependencies() error {
+// Swap provides a mock function with given fields: abstract, impl
+func (_m *MockApplication) Swap(abstract string, impl interface{}) (*core.BindingSwap, error) {
+	ret := _m.Called(abstract, impl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Swap")
+	}
+
+	var r0 *core.BindingSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, interface{}) (*core.BindingSwap, error)); ok {
+		return rf(abstract, impl)
+	}
+	if rf, ok := ret.Get(0).(func(string, interface{}) *core.BindingSwap); ok {
+		r0 = rf(abstract, impl)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*core.BindingSwap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, interface{}) error); ok {
+		r1 = rf(abstract, impl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockApplication_Swap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Swap'
+type MockApplication_Swap_Call struct {
+	*mock.Call
+}
+
+// Swap is a helper method to define mock.On call
+//   - abstract string
+//   - impl interface{}
+func (_e *MockApplication_Expecter) Swap(abstract interface{}, impl interface{}) *MockApplication_Swap_Call {
+	return &MockApplication_Swap_Call{Call: _e.mock.On("Swap", abstract, impl)}
+}
+
+func (_c *MockApplication_Swap_Call) Run(run func(abstract string, impl interface{})) *MockApplication_Swap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockApplication_Swap_Call) Return(_a0 *core.BindingSwap, _a1 error) *MockApplication_Swap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockApplication_Swap_Call) RunAndReturn(run func(string, interface{}) (*core.BindingSwap, error)) *MockApplication_Swap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tracer provides a mock function with no fields
+func (_m *MockApplication) Tracer() core.Tracer {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for RegisterWithDependencies")
+		panic("no return value specified for Tracer")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
+	var r0 core.Tracer
+	if rf, ok := ret.Get(0).(func() core.Tracer); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(core.Tracer)
+		}
 	}
 
 	return r0
 }
 
-// MockApplication_RegisterWithDependencies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterWithDependencies'
-type MockApplication_RegisterWithDependencies_Call struct {
+// MockApplication_Tracer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tracer'
+type MockApplication_Tracer_Call struct {
 	*mock.Call
 }
 
-// RegisterWithDependencies is a helper method to define mock.On call
-func (_e *MockApplication_Expecter) RegisterWithDependencies() *MockApplication_RegisterWithDependencies_Call {
-	return &MockApplication_RegisterWithDependencies_Call{Call: _e.mock.On("RegisterWithDependencies")}
+// Tracer is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) Tracer() *MockApplication_Tracer_Call {
+	return &MockApplication_Tracer_Call{Call: _e.mock.On("Tracer")}
 }
 
-func (_c *MockApplication_RegisterWithDependencies_Call) Run(run func()) *MockApplication_RegisterWithDependencies_Call {
+func (_c *MockApplication_Tracer_Call) Run(run func()) *MockApplication_Tracer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockApplication_RegisterWithDependencies_Call) Return(_a0 error) *MockApplication_RegisterWithDependencies_Call {
+func (_c *MockApplication_Tracer_Call) Return(_a0 core.Tracer) *MockApplication_Tracer_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockApplication_RegisterWithDependencies_Call) RunAndReturn(run func() error) *MockApplication_RegisterWithDependencies_Call {
+func (_c *MockApplication_Tracer_Call) RunAndReturn(run func() core.Tracer) *MockApplication_Tracer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Singleton provides a mock function with given fields: abstract, concrete
-func (_m *MockApplication) Singleton(abstract string, concrete di.BindingFunc) {
-	_m.Called(abstract, concrete)
+// UnloadProvider provides a mock function with given fields: ctx, provider
+func (_m *MockApplication) UnloadProvider(ctx context.Context, provider di.ServiceProvider) error {
+	ret := _m.Called(ctx, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnloadProvider")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, di.ServiceProvider) error); ok {
+		r0 = rf(ctx, provider)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
 
-// MockApplication_Singleton_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Singleton'
-type MockApplication_Singleton_Call struct {
+// MockApplication_UnloadProvider_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnloadProvider'
+type MockApplication_UnloadProvider_Call struct {
 	*mock.Call
 }
 
-// Singleton is a helper method to define mock.On call
-//   - abstract string
-//   - concrete di.BindingFunc
-func (_e *MockApplication_Expecter) Singleton(abstract interface{}, concrete interface{}) *MockApplication_Singleton_Call {
-	return &MockApplication_Singleton_Call{Call: _e.mock.On("Singleton", abstract, concrete)}
+// UnloadProvider is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider di.ServiceProvider
+func (_e *MockApplication_Expecter) UnloadProvider(ctx interface{}, provider interface{}) *MockApplication_UnloadProvider_Call {
+	return &MockApplication_UnloadProvider_Call{Call: _e.mock.On("UnloadProvider", ctx, provider)}
 }
 
-func (_c *MockApplication_Singleton_Call) Run(run func(abstract string, concrete di.BindingFunc)) *MockApplication_Singleton_Call {
+func (_c *MockApplication_UnloadProvider_Call) Run(run func(ctx context.Context, provider di.ServiceProvider)) *MockApplication_UnloadProvider_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(di.BindingFunc))
+		run(args[0].(context.Context), args[1].(di.ServiceProvider))
 	})
 	return _c
 }
 
-func (_c *MockApplication_Singleton_Call) Return() *MockApplication_Singleton_Call {
-	_c.Call.Return()
+func (_c *MockApplication_UnloadProvider_Call) Return(_a0 error) *MockApplication_UnloadProvider_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockApplication_Singleton_Call) RunAndReturn(run func(string, di.BindingFunc)) *MockApplication_Singleton_Call {
-	_c.Run(run)
+func (_c *MockApplication_UnloadProvider_Call) RunAndReturn(run func(context.Context, di.ServiceProvider) error) *MockApplication_UnloadProvider_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Validate provides a mock function with no fields
+func (_m *MockApplication) Validate() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockApplication_Validate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validate'
+type MockApplication_Validate_Call struct {
+	*mock.Call
+}
+
+// Validate is a helper method to define mock.On call
+func (_e *MockApplication_Expecter) Validate() *MockApplication_Validate_Call {
+	return &MockApplication_Validate_Call{Call: _e.mock.On("Validate")}
+}
+
+func (_c *MockApplication_Validate_Call) Run(run func()) *MockApplication_Validate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockApplication_Validate_Call) Return(_a0 error) *MockApplication_Validate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockApplication_Validate_Call) RunAndReturn(run func() error) *MockApplication_Validate_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
@@ -2,7 +2,11 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // MockModuleLoaderContract is an autogenerated mock type for the ModuleLoaderContract type
 type MockModuleLoaderContract struct {
@@ -62,6 +66,52 @@ func (_c *MockModuleLoaderContract_BootstrapApplication_Call) RunAndReturn(run f
 	return _c
 }
 
+// BootstrapApplicationContext provides a mock function with given fields: ctx
+func (_m *MockModuleLoaderContract) BootstrapApplicationContext(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BootstrapApplicationContext")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockModuleLoaderContract_BootstrapApplicationContext_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BootstrapApplicationContext'
+type MockModuleLoaderContract_BootstrapApplicationContext_Call struct {
+	*mock.Call
+}
+
+// BootstrapApplicationContext is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockModuleLoaderContract_Expecter) BootstrapApplicationContext(ctx interface{}) *MockModuleLoaderContract_BootstrapApplicationContext_Call {
+	return &MockModuleLoaderContract_BootstrapApplicationContext_Call{Call: _e.mock.On("BootstrapApplicationContext", ctx)}
+}
+
+func (_c *MockModuleLoaderContract_BootstrapApplicationContext_Call) Run(run func(ctx context.Context)) *MockModuleLoaderContract_BootstrapApplicationContext_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockModuleLoaderContract_BootstrapApplicationContext_Call) Return(_a0 error) *MockModuleLoaderContract_BootstrapApplicationContext_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockModuleLoaderContract_BootstrapApplicationContext_Call) RunAndReturn(run func(context.Context) error) *MockModuleLoaderContract_BootstrapApplicationContext_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadModule provides a mock function with given fields: module
 func (_m *MockModuleLoaderContract) LoadModule(module interface{}) error {
 	ret := _m.Called(module)
@@ -163,6 +213,52 @@ func (_c *MockModuleLoaderContract_LoadModules_Call) RunAndReturn(run func(...in
 	return _c
 }
 
+// LoadPlugin provides a mock function with given fields: path
+func (_m *MockModuleLoaderContract) LoadPlugin(path string) error {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoadPlugin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockModuleLoaderContract_LoadPlugin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadPlugin'
+type MockModuleLoaderContract_LoadPlugin_Call struct {
+	*mock.Call
+}
+
+// LoadPlugin is a helper method to define mock.On call
+//   - path string
+func (_e *MockModuleLoaderContract_Expecter) LoadPlugin(path interface{}) *MockModuleLoaderContract_LoadPlugin_Call {
+	return &MockModuleLoaderContract_LoadPlugin_Call{Call: _e.mock.On("LoadPlugin", path)}
+}
+
+func (_c *MockModuleLoaderContract_LoadPlugin_Call) Run(run func(path string)) *MockModuleLoaderContract_LoadPlugin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockModuleLoaderContract_LoadPlugin_Call) Return(_a0 error) *MockModuleLoaderContract_LoadPlugin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockModuleLoaderContract_LoadPlugin_Call) RunAndReturn(run func(string) error) *MockModuleLoaderContract_LoadPlugin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RegisterCoreProviders provides a mock function with no fields
 func (_m *MockModuleLoaderContract) RegisterCoreProviders() error {
 	ret := _m.Called()
@@ -208,6 +304,99 @@ func (_c *MockModuleLoaderContract_RegisterCoreProviders_Call) RunAndReturn(run
 	return _c
 }
 
+// ReplaceModule provides a mock function with given fields: old, replacement
+func (_m *MockModuleLoaderContract) ReplaceModule(old interface{}, replacement interface{}) error {
+	ret := _m.Called(old, replacement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplaceModule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}, interface{}) error); ok {
+		r0 = rf(old, replacement)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockModuleLoaderContract_ReplaceModule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplaceModule'
+type MockModuleLoaderContract_ReplaceModule_Call struct {
+	*mock.Call
+}
+
+// ReplaceModule is a helper method to define mock.On call
+//   - old interface{}
+//   - replacement interface{}
+func (_e *MockModuleLoaderContract_Expecter) ReplaceModule(old interface{}, replacement interface{}) *MockModuleLoaderContract_ReplaceModule_Call {
+	return &MockModuleLoaderContract_ReplaceModule_Call{Call: _e.mock.On("ReplaceModule", old, replacement)}
+}
+
+func (_c *MockModuleLoaderContract_ReplaceModule_Call) Run(run func(old interface{}, replacement interface{})) *MockModuleLoaderContract_ReplaceModule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockModuleLoaderContract_ReplaceModule_Call) Return(_a0 error) *MockModuleLoaderContract_ReplaceModule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockModuleLoaderContract_ReplaceModule_Call) RunAndReturn(run func(interface{}, interface{}) error) *MockModuleLoaderContract_ReplaceModule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnloadModule provides a mock function with given fields: module
+func (_m *MockModuleLoaderContract) UnloadModule(module interface{}) error {
+	ret := _m.Called(module)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnloadModule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(module)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockModuleLoaderContract_UnloadModule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnloadModule'
+type MockModuleLoaderContract_UnloadModule_Call struct {
+	*mock.Call
+}
+
+// UnloadModule is a helper method to define mock.On call
+//   - module interface{}
+func (_e *MockModuleLoaderContract_Expecter) UnloadModule(module interface{}) *MockModuleLoaderContract_UnloadModule_Call {
+	return &MockModuleLoaderContract_UnloadModule_Call{Call: _e.mock.On("UnloadModule", module)}
+}
+
+func (_c *MockModuleLoaderContract_UnloadModule_Call) Run(run func(module interface{})) *MockModuleLoaderContract_UnloadModule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *MockModuleLoaderContract_UnloadModule_Call) Return(_a0 error) *MockModuleLoaderContract_UnloadModule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockModuleLoaderContract_UnloadModule_Call) RunAndReturn(run func(interface{}) error) *MockModuleLoaderContract_UnloadModule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockModuleLoaderContract creates a new instance of MockModuleLoaderContract. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockModuleLoaderContract(t interface {
@@ -0,0 +1,629 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.fork.vn/di"
+)
+
+// LoadProviders đăng ký và boot providers vào application đã boot.
+//
+// Implement Application interface method.
+//
+// Dependencies của providers mới được kiểm tra với graph hiện tại (providers
+// đã đăng ký cộng với các providers trong batch). Providers trong batch được
+// sắp xếp theo dependency order, đăng ký rồi boot lần lượt, và được thêm vào
+// cuối sortedProviders để Shutdown() giải phóng chúng trước các dependencies.
+// Providers đã đăng ký trước đó được bỏ qua. Manifests của providers implement
+// Module được kiểm tra tương thích với các modules đã load.
+//
+// Nếu boot thất bại, cả batch được rollback: providers của batch đã boot được
+// teardown qua ShutdownProvider theo thứ tự ngược, bindings của batch bị gỡ
+// khỏi container, rồi batch bị gỡ khỏi danh sách providers và BootReport, nên
+// có thể gọi lại LoadProviders với batch đó.
+//
+// Các lần gọi LoadProviders, UnloadProvider và ReplaceProvider đồng thời được
+// thực hiện lần lượt: kiểm tra providers đã đăng ký và việc thêm batch không
+// xen kẽ với nhau, nên một provider không bị load hai lần. Register/Boot của
+// providers trong batch không được gọi lại các methods này.
+//
+// Tham số:
+//   - ctx: context.Context - Context boot
+//   - providers: ...di.ServiceProvider - Providers cần load
+//
+// Trả về:
+//   - error: *MissingDependenciesError, *ModuleCompatibilityError, lỗi circular dependency hoặc lỗi boot
+func (a *application) LoadProviders(ctx context.Context, providers ...di.ServiceProvider) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	a.modulesMu.Lock()
+	defer a.modulesMu.Unlock()
+
+	existing := a.ServiceProviders()
+	registered := make(map[string]bool, len(existing))
+	for _, provider := range existing {
+		registered[getProviderKey(provider)] = true
+	}
+
+	batch := make(map[string]bool, len(providers))
+	all := existing
+	for _, provider := range providers {
+		if provider == nil {
+			return fmt.Errorf("service provider cannot be nil")
+		}
+		key := getProviderKey(provider)
+		if registered[key] || batch[key] {
+			continue
+		}
+		batch[key] = true
+		all = append(all, provider)
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+
+	graph := newProviderGraph(all)
+	if err := graph.missingError(batch); err != nil {
+		return err
+	}
+	if err := checkModules(all, batch, true); err != nil {
+		return err
+	}
+
+	levels, unsorted := graph.levels()
+	for _, key := range unsorted {
+		if batch[key] {
+			return graph.cycleError(batch)
+		}
+	}
+
+	newLevels := make([][]di.ServiceProvider, 0)
+	ordered := make([]di.ServiceProvider, 0, len(batch))
+	for _, level := range levels {
+		providersInLevel := make([]di.ServiceProvider, 0)
+		for _, key := range level {
+			if batch[key] {
+				providersInLevel = append(providersInLevel, graph.providers[key])
+			}
+		}
+		if len(providersInLevel) > 0 {
+			newLevels = append(newLevels, providersInLevel)
+			ordered = append(ordered, providersInLevel...)
+		}
+	}
+
+	a.providersMu.Lock()
+	a.providers = append(a.providers, ordered...)
+	if len(a.sortedProviders) > 0 {
+		a.sortedProviders = append(a.sortedProviders, ordered...)
+		a.bootLevels = append(a.bootLevels, newLevels...)
+	}
+	a.providersMu.Unlock()
+
+	for _, provider := range ordered {
+		a.registerProvider(ctx, a, provider)
+	}
+
+	if err := a.bootSequential(ctx, ordered, a.bootOptions()); err != nil {
+		return a.rollbackProviders(ctx, ordered, batch, err)
+	}
+	return nil
+}
+
+// rollbackProviders gỡ một batch của LoadProviders khi boot thất bại.
+//
+// Providers đã boot trong batch được teardown theo thứ tự ngược với thứ tự
+// boot. Teardown dùng context không bị hủy cùng ctx để vẫn chạy khi boot thất
+// bại do ctx bị hủy. Bindings của batch được gỡ hoặc khôi phục về bindings
+// mà chúng che khuất.
+//
+// Tham số:
+//   - ctx: context.Context - Context boot của batch
+//   - ordered: []di.ServiceProvider - Providers của batch theo thứ tự boot
+//   - batch: map[string]bool - Provider keys của batch
+//   - err: error - Lỗi boot
+//
+// Trả về:
+//   - error: Lỗi boot, gộp với lỗi teardown nếu có
+func (a *application) rollbackProviders(ctx context.Context, ordered []di.ServiceProvider, batch map[string]bool, err error) error {
+	errs := []error{err}
+	teardownCtx := context.WithoutCancel(ctx)
+	for i := len(ordered) - 1; i >= 0; i-- {
+		provider := ordered[i]
+		if shutdowner, ok := provider.(ShutdownProvider); ok && a.recorder.isBooted(provider) {
+			if shutdownErr := a.shutdownProvider(teardownCtx, provider, shutdowner); shutdownErr != nil {
+				errs = append(errs, shutdownErr)
+			}
+		}
+	}
+
+	for i := len(ordered) - 1; i >= 0; i-- {
+		a.releaseBindings(ordered[i], nil)
+	}
+	a.removeProviders(batch)
+	for _, provider := range ordered {
+		a.recorder.forgetProvider(provider)
+	}
+	if len(errs) == 1 {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+// removeProviders gỡ providers khỏi danh sách đăng ký, thứ tự boot và dependency levels.
+//
+// Tham số:
+//   - keys: map[string]bool - Provider keys cần gỡ
+func (a *application) removeProviders(keys map[string]bool) {
+	a.providersMu.Lock()
+	defer a.providersMu.Unlock()
+
+	a.providers = withoutProviders(a.providers, keys)
+	if len(a.sortedProviders) > 0 {
+		a.sortedProviders = withoutProviders(a.sortedProviders, keys)
+	}
+	levels := make([][]di.ServiceProvider, 0, len(a.bootLevels))
+	for _, level := range a.bootLevels {
+		if level = withoutProviders(level, keys); len(level) > 0 {
+			levels = append(levels, level)
+		}
+	}
+	a.bootLevels = levels
+}
+
+// ProviderDependent mô tả một provider còn require services của provider cần unload.
+type ProviderDependent struct {
+	// Provider là tên (type) của provider phụ thuộc
+	Provider string
+	// Services là các services của provider cần unload mà provider này require
+	Services []string
+}
+
+// ProviderInUseError là lỗi trả về khi unload provider vẫn còn providers khác phụ thuộc.
+type ProviderInUseError struct {
+	// Provider là tên (type) của provider cần unload
+	Provider string
+	// Dependents là các providers còn require services của provider, theo thứ tự đăng ký
+	Dependents []ProviderDependent
+}
+
+// Error implement error interface.
+//
+// Trả về:
+//   - string: Error message liệt kê các providers phụ thuộc và services chúng require
+func (e *ProviderInUseError) Error() string {
+	dependents := make([]string, 0, len(e.Dependents))
+	for _, dependent := range e.Dependents {
+		dependents = append(dependents, fmt.Sprintf("%s (%s)", dependent.Provider, quoteServices(dependent.Services)))
+	}
+	return fmt.Sprintf("cannot unload service provider %s: still required by %s", e.Provider, strings.Join(dependents, ", "))
+}
+
+// UnloadProvider gỡ một provider đã đăng ký khỏi application.
+//
+// Implement Application interface method.
+//
+// Provider chỉ được gỡ khi không provider còn lại nào require services mà chỉ
+// nó cung cấp. Nếu provider đã boot và implement ShutdownProvider, Shutdown
+// được gọi trước khi gỡ; lỗi shutdown giữ nguyên provider. Bindings provider
+// tạo trong Register (kể cả aliases và services không khai báo trong
+// Providers()) cùng các services không còn provider nào cung cấp bị gỡ khỏi
+// container: Bound trả về false và Make trả về lỗi cho tới khi chúng được bind
+// lại. Nếu binding của provider đã che khuất một binding trước đó, binding
+// trước đó được khôi phục; binding đã bị ghi đè sau Register được giữ nguyên.
+//
+// Tham số:
+//   - ctx: context.Context - Context cho teardown của provider
+//   - provider: di.ServiceProvider - Provider cần gỡ
+//
+// Trả về:
+//   - error: *ProviderInUseError, *ProviderShutdownError hoặc lỗi provider chưa đăng ký
+func (a *application) UnloadProvider(ctx context.Context, provider di.ServiceProvider) error {
+	if provider == nil {
+		return fmt.Errorf("service provider cannot be nil")
+	}
+
+	a.modulesMu.Lock()
+	defer a.modulesMu.Unlock()
+
+	keys := map[string]bool{getProviderKey(provider): true}
+	existing := a.ServiceProviders()
+	remaining := withoutProviders(existing, keys)
+	if len(remaining) == len(existing) {
+		return fmt.Errorf("service provider %s is not registered", providerName(provider))
+	}
+
+	orphaned := orphanedServices(provider, remaining)
+	if dependents := providerDependents(orphaned, remaining); len(dependents) > 0 {
+		return &ProviderInUseError{Provider: providerName(provider), Dependents: dependents}
+	}
+
+	if shutdowner, ok := provider.(ShutdownProvider); ok && a.recorder.isBooted(provider) {
+		if err := a.shutdownProvider(ctx, provider, shutdowner); err != nil {
+			return err
+		}
+	}
+
+	a.releaseBindings(provider, orphaned)
+
+	a.removeProviders(keys)
+	a.recorder.forgetProvider(provider)
+	return nil
+}
+
+// withoutProviders trả về bản sao của providers không gồm các providers có key cho trước.
+//
+// Tham số:
+//   - providers: []di.ServiceProvider - Danh sách providers
+//   - keys: map[string]bool - Provider keys cần loại bỏ
+//
+// Trả về:
+//   - []di.ServiceProvider: Danh sách mới, giữ nguyên thứ tự
+func withoutProviders(providers []di.ServiceProvider, keys map[string]bool) []di.ServiceProvider {
+	result := make([]di.ServiceProvider, 0, len(providers))
+	for _, provider := range providers {
+		if !keys[getProviderKey(provider)] {
+			result = append(result, provider)
+		}
+	}
+	return result
+}
+
+// orphanedServices trả về services của provider mà không provider còn lại nào cung cấp.
+//
+// Tham số:
+//   - provider: di.ServiceProvider - Provider cần gỡ
+//   - remaining: []di.ServiceProvider - Providers còn lại sau khi gỡ
+//
+// Trả về:
+//   - []string: Services sẽ không còn được cung cấp
+func orphanedServices(provider di.ServiceProvider, remaining []di.ServiceProvider) []string {
+	provided := make(map[string]bool)
+	for _, other := range remaining {
+		for _, service := range other.Providers() {
+			provided[service] = true
+		}
+	}
+
+	orphaned := make([]string, 0)
+	for _, service := range provider.Providers() {
+		if !provided[service] {
+			orphaned = append(orphaned, service)
+		}
+	}
+	return orphaned
+}
+
+// providerDependents tìm các providers require một trong các services cho trước.
+//
+// Tham số:
+//   - services: []string - Services sẽ không còn được cung cấp
+//   - providers: []di.ServiceProvider - Providers còn lại
+//
+// Trả về:
+//   - []ProviderDependent: Providers phụ thuộc, theo thứ tự đăng ký
+func providerDependents(services []string, providers []di.ServiceProvider) []ProviderDependent {
+	removed := make(map[string]bool, len(services))
+	for _, service := range services {
+		removed[service] = true
+	}
+
+	dependents := make([]ProviderDependent, 0)
+	for _, provider := range providers {
+		required := make([]string, 0)
+		for _, service := range provider.Requires() {
+			if removed[service] {
+				required = append(required, service)
+			}
+		}
+		if len(required) > 0 {
+			dependents = append(dependents, ProviderDependent{Provider: providerName(provider), Services: required})
+		}
+	}
+	return dependents
+}
+
+// ReplaceProvider thay một provider đã đăng ký bằng provider mới mà không dừng application.
+//
+// Implement Application interface method.
+//
+// Khi application đã boot, replacement được register và boot trên một staging
+// container: bindings của nó chỉ thấy được qua application truyền vào
+// Register/Boot của replacement, các goroutines khác vẫn dùng bindings của
+// provider cũ. Nếu boot thất bại, staging bị bỏ và container không thay đổi.
+// Nếu boot thành công, bindings trong staging thay bindings cùng tên trong
+// container, replacement thế chỗ provider cũ trong thứ tự đăng ký, sau đó
+// provider cũ được teardown qua ShutdownProvider và bindings chỉ provider cũ
+// cung cấp bị gỡ khỏi container.
+//
+// Khi application chưa boot, replacement chỉ thế chỗ provider cũ trong danh
+// sách providers. Khi đã boot, manifests của modules được kiểm tra tương thích
+// với replacement trước khi boot nó.
+//
+// Tham số:
+//   - ctx: context.Context - Context cho boot của replacement và teardown của provider cũ
+//   - old: di.ServiceProvider - Provider đang được sử dụng
+//   - replacement: di.ServiceProvider - Provider thay thế
+//
+// Trả về:
+//   - error: Lỗi dependency, lỗi boot của replacement (đã rollback) hoặc lỗi teardown của provider cũ
+func (a *application) ReplaceProvider(ctx context.Context, old, replacement di.ServiceProvider) error {
+	if old == nil || replacement == nil {
+		return fmt.Errorf("service provider cannot be nil")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	a.modulesMu.Lock()
+	defer a.modulesMu.Unlock()
+
+	oldKey, newKey := getProviderKey(old), getProviderKey(replacement)
+	existing := a.ServiceProviders()
+	registered := false
+	for _, provider := range existing {
+		switch getProviderKey(provider) {
+		case oldKey:
+			registered = true
+		case newKey:
+			return fmt.Errorf("service provider %s is already registered", providerName(replacement))
+		}
+	}
+	if !registered {
+		return fmt.Errorf("service provider %s is not registered", providerName(old))
+	}
+	if oldKey == newKey {
+		return fmt.Errorf("service provider %s cannot replace itself", providerName(old))
+	}
+
+	providers := make([]di.ServiceProvider, 0, len(existing))
+	for _, provider := range existing {
+		if getProviderKey(provider) == oldKey {
+			provider = replacement
+		}
+		providers = append(providers, provider)
+	}
+
+	graph := newProviderGraph(providers)
+	batch := map[string]bool{newKey: true}
+	if err := graph.missingError(batch); err != nil {
+		return err
+	}
+	if _, unsorted := graph.levels(); len(unsorted) > 0 {
+		return graph.cycleError(batch)
+	}
+
+	orphaned := orphanedServices(old, providers)
+	if dependents := providerDependents(orphaned, providers); len(dependents) > 0 {
+		return &ProviderInUseError{Provider: providerName(old), Dependents: dependents}
+	}
+
+	if a.State() != StateBooted {
+		if a.setProviders(providers, graph) {
+			a.registerProvider(ctx, a, replacement)
+			a.releaseBindings(old, orphaned)
+			a.recorder.forgetProvider(old)
+		}
+		return nil
+	}
+
+	if err := checkModules(providers, nil, true); err != nil {
+		return err
+	}
+
+	staged := &stagedApplication{application: a, staged: newStagedContainer(a.container)}
+	a.registerProvider(ctx, staged, replacement)
+	if err := a.bootProvider(ctx, staged, replacement, a.bootOptions().timeout); err != nil {
+		a.recorder.forgetProvider(replacement)
+		return fmt.Errorf("failed to replace service provider %s, rolled back: %w", providerName(old), err)
+	}
+
+	a.commitStaged(replacement, staged.staged)
+	a.setProviders(providers, graph)
+
+	var err error
+	if shutdowner, ok := old.(ShutdownProvider); ok && a.recorder.isBooted(old) {
+		err = a.shutdownProvider(ctx, old, shutdowner)
+	}
+	a.releaseBindings(old, orphaned)
+	a.recorder.forgetProvider(old)
+	return err
+}
+
+// stagedApplication là view của application cho replacement trong ReplaceProvider.
+//
+// Bindings của replacement được ghi vào stagedContainer, nên chúng chỉ thay
+// bindings của provider cũ khi replacement boot thành công.
+type stagedApplication struct {
+	*application
+	staged *stagedContainer
+}
+
+// Container trả về staging container.
+//
+// Trả về:
+//   - di.Container: Staging container trên container đang chạy
+func (s *stagedApplication) Container() di.Container {
+	return s.staged
+}
+
+// Bind ghi binding vào staging.
+//
+// Tham số:
+//   - abstract: string - Abstract type name
+//   - concrete: di.BindingFunc - Factory function
+func (s *stagedApplication) Bind(abstract string, concrete di.BindingFunc) {
+	s.staged.Bind(abstract, concrete)
+}
+
+// Singleton ghi singleton binding vào staging.
+//
+// Tham số:
+//   - abstract: string - Abstract type name
+//   - concrete: di.BindingFunc - Factory function
+func (s *stagedApplication) Singleton(abstract string, concrete di.BindingFunc) {
+	s.staged.Singleton(abstract, concrete)
+}
+
+// Instance ghi instance vào staging.
+//
+// Tham số:
+//   - abstract: string - Abstract type name
+//   - instance: interface{} - Instance object
+func (s *stagedApplication) Instance(abstract string, instance interface{}) {
+	s.staged.Instance(abstract, instance)
+}
+
+// Alias ghi alias vào staging.
+//
+// Tham số:
+//   - abstract: string - Original abstract name
+//   - alias: string - Alias name
+func (s *stagedApplication) Alias(abstract, alias string) {
+	s.staged.Alias(abstract, alias)
+}
+
+// Make resolve từ staging, hoặc từ container đang chạy nếu staging không có.
+//
+// Tham số:
+//   - abstract: string - Abstract type name
+//
+// Trả về:
+//   - interface{}: Resolved instance
+//   - error: Lỗi nếu resolve thất bại
+func (s *stagedApplication) Make(abstract string) (interface{}, error) {
+	return s.staged.Make(abstract)
+}
+
+// MustMake resolve từ staging, panic nếu lỗi.
+//
+// Tham số:
+//   - abstract: string - Abstract type name
+//
+// Trả về:
+//   - interface{}: Resolved instance
+func (s *stagedApplication) MustMake(abstract string) interface{} {
+	return s.staged.MustMake(abstract)
+}
+
+// commitStaged ghi bindings trong staging vào container đang chạy.
+//
+// Singletons đã resolve trong lúc boot giữ nguyên instance đó; các bindings
+// khác được ghi với định nghĩa gốc. Tất cả bindings được ghi trong một lần
+// thay bảng definitions của container, nên Make đồng thời thấy hoặc toàn bộ
+// bindings cũ hoặc toàn bộ bindings mới. Bindings được ghi nhận là của
+// replacement.
+//
+// Tham số:
+//   - replacement: di.ServiceProvider - Provider đã tạo bindings trong staging
+//   - staged: *stagedContainer - Staging của replacement đã boot thành công
+func (a *application) commitStaged(replacement di.ServiceProvider, staged *stagedContainer) {
+	bindings := staged.staged()
+	definitions := make([]*bindingDefinition, 0, len(bindings))
+	for i := range bindings {
+		a.auditBind(bindings[i].abstract)
+		definitions = append(definitions, &bindings[i])
+	}
+
+	revision := a.container.trackBindings()
+	a.container.define(definitions...)
+	a.ownBindings(replacement, a.container.claimBindings(revision))
+}
+
+// ownBindings ghi nhận definitions thuộc provider.
+//
+// Tham số:
+//   - provider: di.ServiceProvider - Provider tạo bindings
+//   - definitions: []*bindingDefinition - Definitions trả về bởi claimBindings
+func (a *application) ownBindings(provider di.ServiceProvider, definitions []*bindingDefinition) {
+	if len(definitions) == 0 {
+		return
+	}
+
+	a.providersMu.Lock()
+	defer a.providersMu.Unlock()
+	key := getProviderKey(provider)
+	a.bindings[key] = append(a.bindings[key], definitions...)
+}
+
+// releaseBindings gỡ bindings của provider khỏi container.
+//
+// Bindings provider tạo trong Register, kể cả aliases và bindings không khai
+// báo trong Providers(), được khôi phục về binding mà chúng che khuất hoặc bị
+// gỡ nếu không có binding nào trước đó; bindings đã bị ghi đè sau đó được giữ
+// nguyên. Các services trong orphaned không được ghi nhận trong Register (ví
+// dụ được bind trong Boot) cũng bị gỡ.
+//
+// Tham số:
+//   - provider: di.ServiceProvider - Provider cần gỡ bindings
+//   - orphaned: []string - Services không còn provider nào cung cấp
+func (a *application) releaseBindings(provider di.ServiceProvider, orphaned []string) {
+	key := getProviderKey(provider)
+	a.providersMu.Lock()
+	definitions := a.bindings[key]
+	delete(a.bindings, key)
+	a.providersMu.Unlock()
+
+	a.container.release(definitions)
+
+	owned := make(map[string]bool, len(definitions))
+	for _, definition := range definitions {
+		owned[definition.abstract] = true
+	}
+	untracked := make([]string, 0, len(orphaned))
+	for _, service := range orphaned {
+		if !owned[service] {
+			untracked = append(untracked, service)
+		}
+	}
+	a.forgetServices(untracked)
+}
+
+// forgetServices gỡ bindings của services khỏi container.
+//
+// Tham số:
+//   - services: []string - Tên services cần gỡ
+func (a *application) forgetServices(services []string) {
+	for _, service := range services {
+		a.container.Forget(service)
+	}
+}
+
+// setProviders thay danh sách providers và cập nhật thứ tự boot từ graph của chúng.
+//
+// Thứ tự boot và dependency levels chỉ được tính lại nếu providers đã được
+// sắp xếp trước đó qua RegisterWithDependencies().
+//
+// Tham số:
+//   - providers: []di.ServiceProvider - Danh sách providers mới theo thứ tự đăng ký
+//   - graph: *providerGraph - Graph của providers, không có cycle
+//
+// Trả về:
+//   - bool: true nếu thứ tự boot đã được tính lại
+func (a *application) setProviders(providers []di.ServiceProvider, graph *providerGraph) bool {
+	a.providersMu.Lock()
+	defer a.providersMu.Unlock()
+
+	a.providers = providers
+	if len(a.sortedProviders) == 0 {
+		return false
+	}
+
+	levels, _ := graph.levels()
+	a.sortedProviders = make([]di.ServiceProvider, 0, len(graph.keys))
+	a.bootLevels = make([][]di.ServiceProvider, 0, len(levels))
+	for _, level := range levels {
+		sorted := make([]di.ServiceProvider, 0, len(level))
+		for _, key := range level {
+			sorted = append(sorted, graph.providers[key])
+		}
+		a.sortedProviders = append(a.sortedProviders, sorted...)
+		a.bootLevels = append(a.bootLevels, sorted)
+	}
+	return true
+}
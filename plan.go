@@ -0,0 +1,248 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProblemKind phân loại vấn đề tìm được khi phân tích dependency graph.
+type ProblemKind string
+
+const (
+	// ProblemMissingService là service được require nhưng không provider nào cung cấp
+	ProblemMissingService ProblemKind = "missing_service"
+	// ProblemCircularDependency là các providers phụ thuộc vòng lẫn nhau
+	ProblemCircularDependency ProblemKind = "circular_dependency"
+	// ProblemDuplicateProvider là cùng một provider instance được đăng ký nhiều lần
+	ProblemDuplicateProvider ProblemKind = "duplicate_provider"
+	// ProblemDuplicateService là service được nhiều providers cung cấp, provider đăng ký sau thắng
+	ProblemDuplicateService ProblemKind = "duplicate_service"
+	// ProblemUnusedService là service được cung cấp nhưng không provider nào require
+	ProblemUnusedService ProblemKind = "unused_service"
+)
+
+// ProblemSeverity là mức độ nghiêm trọng của một vấn đề.
+type ProblemSeverity string
+
+const (
+	// SeverityError là vấn đề khiến RegisterWithDependencies() thất bại
+	SeverityError ProblemSeverity = "error"
+	// SeverityWarning là vấn đề không ngăn application khởi động
+	SeverityWarning ProblemSeverity = "warning"
+)
+
+// PlanProblem mô tả một vấn đề tìm được khi phân tích dependency graph.
+type PlanProblem struct {
+	// Kind là loại vấn đề
+	Kind ProblemKind `json:"kind"`
+	// Severity là mức độ nghiêm trọng
+	Severity ProblemSeverity `json:"severity"`
+	// Service là service liên quan, rỗng với ProblemCircularDependency và ProblemDuplicateProvider
+	Service string `json:"service,omitempty"`
+	// Providers là tên các providers liên quan; với cycle là chuỗi phụ thuộc theo thứ tự
+	Providers []string `json:"providers"`
+	// Message là mô tả dễ đọc
+	Message string `json:"message"`
+}
+
+// Plan là kết quả phân tích dependency graph của các providers đã đăng ký.
+type Plan struct {
+	// Order là tên providers theo thứ tự boot dự kiến, không gồm providers nằm trong cycle
+	Order []string `json:"order"`
+	// Levels là tên providers nhóm theo dependency level
+	Levels [][]string `json:"levels"`
+	// Problems là tất cả vấn đề tìm được
+	Problems []PlanProblem `json:"problems"`
+}
+
+// Errors trả về các vấn đề có severity SeverityError.
+//
+// Trả về:
+//   - []PlanProblem: Vấn đề khiến application không thể khởi động
+func (p *Plan) Errors() []PlanProblem {
+	return p.filter(SeverityError)
+}
+
+// Warnings trả về các vấn đề có severity SeverityWarning.
+//
+// Trả về:
+//   - []PlanProblem: Vấn đề không ngăn application khởi động
+func (p *Plan) Warnings() []PlanProblem {
+	return p.filter(SeverityWarning)
+}
+
+// Valid cho biết plan không có vấn đề nào ở mức SeverityError.
+//
+// Trả về:
+//   - bool: true nếu có thể đăng ký và boot providers theo plan
+func (p *Plan) Valid() bool {
+	return len(p.Errors()) == 0
+}
+
+// filter lọc problems theo severity.
+func (p *Plan) filter(severity ProblemSeverity) []PlanProblem {
+	problems := make([]PlanProblem, 0)
+	for _, problem := range p.Problems {
+		if problem.Severity == severity {
+			problems = append(problems, problem)
+		}
+	}
+	return problems
+}
+
+// ValidationError chứa tất cả vấn đề ở mức SeverityError tìm được bởi Validate().
+type ValidationError struct {
+	// Problems là các vấn đề tìm được
+	Problems []PlanProblem
+}
+
+// Error implement error interface.
+//
+// Trả về:
+//   - string: Error message liệt kê từng vấn đề trên một dòng
+func (e *ValidationError) Error() string {
+	lines := make([]string, 0, len(e.Problems)+1)
+	lines = append(lines, fmt.Sprintf("dependency validation failed with %d problem(s):", len(e.Problems)))
+	for _, problem := range e.Problems {
+		lines = append(lines, "  - "+problem.Message)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Plan phân tích dependency graph của các providers đã đăng ký mà không gây side effects.
+//
+// Implement Application interface method.
+//
+// Không provider nào được Register hay Boot; chỉ Providers() và Requires()
+// được gọi. Tất cả vấn đề được thu thập thay vì dừng ở vấn đề đầu tiên.
+//
+// Trả về:
+//   - *Plan: Thứ tự boot dự kiến và các vấn đề tìm được
+func (a *application) Plan() *Plan {
+	graph := newProviderGraph(a.ServiceProviders())
+	levels, _ := graph.levels()
+
+	plan := &Plan{
+		Order:    make([]string, 0, len(graph.keys)),
+		Levels:   make([][]string, 0, len(levels)),
+		Problems: make([]PlanProblem, 0),
+	}
+	for _, level := range levels {
+		names := make([]string, 0, len(level))
+		for _, key := range level {
+			names = append(names, graph.name(key))
+		}
+		plan.Levels = append(plan.Levels, names)
+		plan.Order = append(plan.Order, names...)
+	}
+
+	provided := make([]string, 0, len(graph.services))
+	for service := range graph.services {
+		provided = append(provided, service)
+	}
+	for _, missing := range graph.missing {
+		name := graph.name(missing.provider)
+		message := fmt.Sprintf("%s requires '%s' but no registered provider provides it", name, missing.service)
+		if suggestions := suggestServices(missing.service, provided); len(suggestions) > 0 {
+			message += "; did you mean " + quoteServices(suggestions) + "?"
+		}
+		plan.Problems = append(plan.Problems, PlanProblem{
+			Kind:      ProblemMissingService,
+			Severity:  SeverityError,
+			Service:   missing.service,
+			Providers: []string{name},
+			Message:   message,
+		})
+	}
+
+	for _, cycle := range graph.cycles() {
+		names := make([]string, 0, len(cycle)+1)
+		for _, key := range cycle {
+			names = append(names, graph.name(key))
+		}
+		names = append(names, graph.name(cycle[0]))
+		plan.Problems = append(plan.Problems, PlanProblem{
+			Kind:      ProblemCircularDependency,
+			Severity:  SeverityError,
+			Providers: names,
+			Message:   "circular dependency: " + strings.Join(names, " -> "),
+		})
+	}
+
+	reported := make(map[string]bool)
+	for _, key := range graph.duplicates {
+		if reported[key] {
+			continue
+		}
+		reported[key] = true
+		name := graph.name(key)
+		plan.Problems = append(plan.Problems, PlanProblem{
+			Kind:      ProblemDuplicateProvider,
+			Severity:  SeverityWarning,
+			Providers: []string{name},
+			Message:   fmt.Sprintf("%s is registered more than once", name),
+		})
+	}
+
+	services := make([]string, 0, len(graph.serviceProviders))
+	for service, keys := range graph.serviceProviders {
+		if len(keys) > 1 {
+			services = append(services, service)
+		}
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		keys := graph.serviceProviders[service]
+		names := make([]string, 0, len(keys))
+		for _, key := range keys {
+			names = append(names, graph.name(key))
+		}
+		plan.Problems = append(plan.Problems, PlanProblem{
+			Kind:      ProblemDuplicateService,
+			Severity:  SeverityWarning,
+			Service:   service,
+			Providers: names,
+			Message: fmt.Sprintf("service '%s' is provided by %d providers (%s), %s wins",
+				service, len(names), strings.Join(names, ", "), names[len(names)-1]),
+		})
+	}
+
+	required := make(map[string]bool)
+	for _, key := range graph.keys {
+		for _, service := range graph.providers[key].Requires() {
+			required[service] = true
+		}
+	}
+	for _, key := range graph.keys {
+		name := graph.name(key)
+		for _, service := range graph.providers[key].Providers() {
+			if required[service] {
+				continue
+			}
+			plan.Problems = append(plan.Problems, PlanProblem{
+				Kind:      ProblemUnusedService,
+				Severity:  SeverityWarning,
+				Service:   service,
+				Providers: []string{name},
+				Message:   fmt.Sprintf("service '%s' provided by %s is not required by any provider", service, name),
+			})
+		}
+	}
+
+	return plan
+}
+
+// Validate kiểm tra dependency graph của các providers đã đăng ký mà không gây side effects.
+//
+// Implement Application interface method.
+//
+// Trả về:
+//   - error: *ValidationError chứa tất cả vấn đề ở mức SeverityError, nil nếu hợp lệ
+func (a *application) Validate() error {
+	errs := a.Plan().Errors()
+	if len(errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: errs}
+}
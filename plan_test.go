@@ -0,0 +1,139 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+)
+
+// problemKinds trả về danh sách kinds của problems theo thứ tự.
+func problemKinds(problems []core.PlanProblem) []core.ProblemKind {
+	kinds := make([]core.ProblemKind, 0, len(problems))
+	for _, problem := range problems {
+		kinds = append(kinds, problem.Kind)
+	}
+	return kinds
+}
+
+// TestApplication_Plan tests dependency analysis without side effects
+func TestApplication_Plan(t *testing.T) {
+	t.Run("orders_providers_by_dependency_level", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		repository := newCommandProvider("repository", []string{"database"})
+		database := newCommandProvider("database", nil)
+		app.Register(repository)
+		app.Register(database)
+
+		plan := app.Plan()
+		assert.True(t, plan.Valid())
+		assert.Len(t, plan.Order, 2)
+		require.Len(t, plan.Levels, 2)
+		assert.Len(t, plan.Levels[0], 1)
+		assert.Len(t, plan.Levels[1], 1)
+
+		assert.False(t, repository.booted)
+		assert.False(t, database.booted)
+		assert.Empty(t, app.BootReport().Providers)
+	})
+
+	t.Run("collects_all_problems", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		a := newCommandProvider("a", []string{"b"})
+		b := newCommandProvider("b", []string{"a"})
+		app.Register(a)
+		app.Register(b)
+		app.Register(newCommandProvider("c", []string{"cache", "queue"}))
+
+		plan := app.Plan()
+		assert.False(t, plan.Valid())
+		assert.Equal(t, []core.ProblemKind{
+			core.ProblemMissingService,
+			core.ProblemMissingService,
+			core.ProblemCircularDependency,
+		}, problemKinds(plan.Errors()))
+
+		missing := plan.Errors()[0]
+		assert.Equal(t, "cache", missing.Service)
+		assert.Equal(t, []string{"*core_test.commandProvider"}, missing.Providers)
+		assert.Equal(t, "*core_test.commandProvider requires 'cache' but no registered provider provides it", missing.Message)
+
+		cycle := plan.Errors()[2]
+		assert.Len(t, cycle.Providers, 3)
+		assert.Len(t, plan.Order, 1)
+	})
+
+	t.Run("reports_warnings", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		first := newCommandProvider("cache", nil)
+		second := newCommandProvider("cache", nil)
+		app.Register(first)
+		app.Register(second)
+		app.Register(second)
+		app.Register(newCommandProvider("api", []string{"cache"}))
+
+		plan := app.Plan()
+		assert.True(t, plan.Valid())
+		assert.Equal(t, []core.ProblemKind{
+			core.ProblemDuplicateProvider,
+			core.ProblemDuplicateService,
+			core.ProblemUnusedService,
+		}, problemKinds(plan.Warnings()))
+
+		duplicate := plan.Warnings()[1]
+		assert.Equal(t, "cache", duplicate.Service)
+		assert.Len(t, duplicate.Providers, 2)
+
+		unused := plan.Warnings()[2]
+		assert.Equal(t, "api", unused.Service)
+	})
+}
+
+// TestApplication_Validate tests dry-run validation
+func TestApplication_Validate(t *testing.T) {
+	t.Run("valid_graph", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("database", nil))
+		app.Register(newCommandProvider("repository", []string{"database"}))
+
+		assert.NoError(t, app.Validate())
+	})
+
+	t.Run("returns_every_error", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("a", []string{"cache"}))
+		app.Register(newCommandProvider("b", []string{"queue"}))
+
+		err := app.Validate()
+		require.Error(t, err)
+
+		var validationErr *core.ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Len(t, validationErr.Problems, 2)
+		assert.Contains(t, err.Error(), "dependency validation failed with 2 problem(s)")
+		assert.Contains(t, err.Error(), "requires 'cache'")
+		assert.Contains(t, err.Error(), "requires 'queue'")
+	})
+
+	t.Run("warnings_do_not_fail_validation", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newCommandProvider("unused", nil))
+
+		assert.NoError(t, app.Validate())
+		assert.Len(t, app.Plan().Warnings(), 1)
+	})
+}
@@ -0,0 +1,180 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"go.fork.vn/di"
+)
+
+// PluginSymbol là tên symbol mà Go plugin module phải export.
+//
+// Symbol phải là function (hoặc biến kiểu function) có dạng
+// func() di.ServiceProvider hoặc func() interface{} trả về giá trị implement
+// di.ServiceProvider.
+const PluginSymbol = "NewServiceProvider"
+
+// ErrPluginsNotSupported là lỗi khi platform hiện tại không hỗ trợ Go plugins.
+//
+// Go plugins chỉ được hỗ trợ trên Linux với cgo được bật.
+var ErrPluginsNotSupported = errors.New("go plugins are not supported on this platform")
+
+// PluginLoadError represent lỗi khi load module từ Go plugin (.so).
+type PluginLoadError struct {
+	// Path là đường dẫn tới plugin
+	Path string
+	// Reason là lý do load thất bại, có thể rỗng khi Cause đã mô tả đủ
+	Reason string
+	// Cause là lỗi gốc
+	Cause error
+}
+
+// Error implement error interface.
+//
+// Trả về:
+//   - string: Error message với đường dẫn plugin, lý do và lỗi gốc
+func (e *PluginLoadError) Error() string {
+	message := fmt.Sprintf("failed to load plugin '%s'", e.Path)
+	if e.Reason != "" {
+		message += ": " + e.Reason
+	}
+	if e.Cause != nil {
+		message += ": " + e.Cause.Error()
+	}
+	return message
+}
+
+// Unwrap trả về lỗi gốc để hỗ trợ errors.Is và errors.As.
+func (e *PluginLoadError) Unwrap() error {
+	return e.Cause
+}
+
+// LoadPlugin load một module từ Go plugin shared object.
+//
+// Implement ModuleLoaderContract interface method.
+//
+// Phương thức này:
+//  1. Mở plugin và lookup symbol PluginSymbol
+//  2. Gọi constructor và kiểm tra kết quả implement di.ServiceProvider
+//  3. Load provider qua LoadModule(), nên manifest và dependencies được kiểm
+//     tra như mọi module khác
+//
+// Tham số:
+//   - path: string - Đường dẫn tới file .so
+//
+// Trả về:
+//   - error: *PluginLoadError bọc lỗi mở plugin, symbol không hợp lệ hoặc lỗi của LoadModule()
+func (l *moduleLoader) LoadPlugin(path string) error {
+	symbol, err := openPlugin(path)
+	if err != nil {
+		return err
+	}
+
+	provider, err := pluginProvider(path, symbol)
+	if err != nil {
+		return err
+	}
+
+	if err := l.LoadModule(provider); err != nil {
+		return &PluginLoadError{Path: path, Cause: err}
+	}
+	return nil
+}
+
+// loadConfiguredPlugins load các plugins khai báo trong config "app.modules.plugins".
+//
+// Tham số:
+//   - app: Application - Application đọc config và load plugins
+//
+// Trả về:
+//   - error: Lỗi của plugin đầu tiên load thất bại, hoặc lỗi nếu config không phải danh sách paths
+func loadConfiguredPlugins(app Application) error {
+	cfg, ok := appConfig(app)
+	if !ok {
+		return nil
+	}
+	value, found := lookupSetting(cfg.AllSettings(), "app.modules.plugins")
+	if !found || value == nil {
+		return nil
+	}
+
+	paths, err := pluginPaths(value)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if err := app.ModuleLoader().LoadPlugin(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pluginPaths chuyển giá trị config thành danh sách đường dẫn plugins.
+//
+// Tham số:
+//   - value: interface{} - Giá trị của "app.modules.plugins"
+//
+// Trả về:
+//   - []string: Đường dẫn plugins theo thứ tự khai báo
+//   - error: Lỗi nếu giá trị không phải danh sách strings
+func pluginPaths(value interface{}) ([]string, error) {
+	switch paths := value.(type) {
+	case []string:
+		return paths, nil
+	case []interface{}:
+		result := make([]string, 0, len(paths))
+		for _, path := range paths {
+			text, ok := path.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid app.modules.plugins entry: expected string, got %T", path)
+			}
+			result = append(result, text)
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("invalid app.modules.plugins type: expected list of paths, got %T", value)
+	}
+}
+
+// pluginProvider tạo service provider từ symbol của plugin.
+//
+// Tham số:
+//   - path: string - Đường dẫn tới plugin, dùng cho error messages
+//   - symbol: interface{} - Symbol PluginSymbol lookup được từ plugin
+//
+// Trả về:
+//   - di.ServiceProvider: Provider do constructor của plugin tạo
+//   - error: *PluginLoadError nếu symbol không phải constructor hợp lệ
+func pluginProvider(path string, symbol interface{}) (di.ServiceProvider, error) {
+	var value interface{}
+	switch constructor := symbol.(type) {
+	case func() di.ServiceProvider:
+		value = constructor()
+	case func() interface{}:
+		value = constructor()
+	case *func() di.ServiceProvider:
+		// plugin.Lookup trả về con trỏ tới biến được export
+		if constructor != nil && *constructor != nil {
+			value = (*constructor)()
+		}
+	case *func() interface{}:
+		if constructor != nil && *constructor != nil {
+			value = (*constructor)()
+		}
+	default:
+		return nil, &PluginLoadError{
+			Path:   path,
+			Reason: fmt.Sprintf("symbol %s has type %T, expected func() di.ServiceProvider", PluginSymbol, symbol),
+		}
+	}
+
+	provider, ok := value.(di.ServiceProvider)
+	if !ok || provider == nil {
+		return nil, &PluginLoadError{
+			Path:   path,
+			Reason: fmt.Sprintf("%s returned %T, which does not implement di.ServiceProvider", PluginSymbol, value),
+		}
+	}
+	return provider, nil
+}
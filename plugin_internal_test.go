@@ -0,0 +1,84 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/di"
+)
+
+// pluginTestProvider là service provider tối giản cho tests của pluginProvider.
+type pluginTestProvider struct{}
+
+func (p *pluginTestProvider) Register(app di.Application) {}
+
+func (p *pluginTestProvider) Boot(app di.Application) {}
+
+func (p *pluginTestProvider) Requires() []string {
+	return nil
+}
+
+func (p *pluginTestProvider) Providers() []string {
+	return []string{"plugin"}
+}
+
+// TestPluginProvider tests creating providers from plugin symbols
+func TestPluginProvider(t *testing.T) {
+	provider := &pluginTestProvider{}
+	typedConstructor := func() di.ServiceProvider { return provider }
+	untypedConstructor := func() interface{} { return provider }
+
+	accepted := []struct {
+		name   string
+		symbol interface{}
+	}{
+		{"typed_constructor", typedConstructor},
+		{"untyped_constructor", untypedConstructor},
+		{"exported_typed_constructor_variable", &typedConstructor},
+		{"exported_untyped_constructor_variable", &untypedConstructor},
+	}
+	for _, tt := range accepted {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := pluginProvider("plugins/billing.so", tt.symbol)
+			require.NoError(t, err)
+			assert.Same(t, provider, result)
+		})
+	}
+
+	var nilConstructor func() di.ServiceProvider
+	rejected := []struct {
+		name   string
+		symbol interface{}
+		reason string
+	}{
+		{"provider_value", provider,
+			"symbol NewServiceProvider has type *core.pluginTestProvider, expected func() di.ServiceProvider"},
+		{"wrong_constructor_signature", func() string { return "billing" },
+			"symbol NewServiceProvider has type func() string, expected func() di.ServiceProvider"},
+		{"constructor_returning_non_provider", func() interface{} { return "billing" },
+			"NewServiceProvider returned string, which does not implement di.ServiceProvider"},
+		{"constructor_returning_nil", func() di.ServiceProvider { return nil },
+			"NewServiceProvider returned <nil>, which does not implement di.ServiceProvider"},
+		{"nil_constructor_variable", &nilConstructor,
+			"NewServiceProvider returned <nil>, which does not implement di.ServiceProvider"},
+	}
+	for _, tt := range rejected {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := pluginProvider("plugins/billing.so", tt.symbol)
+			assert.Nil(t, result)
+
+			var pluginErr *PluginLoadError
+			require.True(t, errors.As(err, &pluginErr))
+			assert.Equal(t, "plugins/billing.so", pluginErr.Path)
+			assert.Equal(t, tt.reason, pluginErr.Reason)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+//go:build linux && cgo
+
+package core
+
+import (
+	"fmt"
+	"plugin"
+)
+
+// openPlugin mở Go plugin và lookup symbol PluginSymbol.
+//
+// Tham số:
+//   - path: string - Đường dẫn tới file .so
+//
+// Trả về:
+//   - interface{}: Symbol của plugin
+//   - error: *PluginLoadError nếu không mở được plugin hoặc không tìm thấy symbol
+func openPlugin(path string) (interface{}, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, &PluginLoadError{Path: path, Cause: err}
+	}
+
+	symbol, err := p.Lookup(PluginSymbol)
+	if err != nil {
+		return nil, &PluginLoadError{Path: path, Reason: fmt.Sprintf("symbol %s not found", PluginSymbol), Cause: err}
+	}
+	return symbol, nil
+}
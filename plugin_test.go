@@ -0,0 +1,53 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+)
+
+// TestModuleLoader_LoadPlugin tests loading modules from Go plugins
+func TestModuleLoader_LoadPlugin(t *testing.T) {
+	setupTestEnvironment(t)
+
+	t.Run("rejects_missing_plugin_file", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		err := app.ModuleLoader().LoadPlugin("testdata/plugins/missing.so")
+
+		var pluginErr *core.PluginLoadError
+		require.True(t, errors.As(err, &pluginErr))
+		assert.Equal(t, "testdata/plugins/missing.so", pluginErr.Path)
+		assert.Contains(t, err.Error(), "failed to load plugin 'testdata/plugins/missing.so'")
+		assert.Empty(t, app.ServiceProviders())
+	})
+
+	t.Run("bootstrap_loads_configured_plugins", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{
+			"file": "testdata/configs/plugins.yaml",
+		})
+
+		err := app.ModuleLoader().BootstrapApplication()
+
+		var pluginErr *core.PluginLoadError
+		require.True(t, errors.As(err, &pluginErr))
+		assert.Equal(t, "testdata/plugins/missing.so", pluginErr.Path)
+		assert.Equal(t, core.StateCreated, app.State())
+	})
+
+	t.Run("bootstrap_without_plugins_config", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{
+			"file": "testdata/configs/console-only-simple.yaml",
+		})
+
+		assert.NoError(t, app.ModuleLoader().BootstrapApplication())
+	})
+}
@@ -0,0 +1,15 @@
+//go:build !linux || !cgo
+
+package core
+
+// openPlugin trả về ErrPluginsNotSupported trên platforms không hỗ trợ Go plugins.
+//
+// Tham số:
+//   - path: string - Đường dẫn tới file .so
+//
+// Trả về:
+//   - interface{}: Luôn nil
+//   - error: *PluginLoadError bọc ErrPluginsNotSupported
+func openPlugin(path string) (interface{}, error) {
+	return nil, &PluginLoadError{Path: path, Cause: ErrPluginsNotSupported}
+}
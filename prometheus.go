@@ -0,0 +1,351 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets là các upper bounds (giây) mặc định của histograms trong PrometheusMetrics.
+var DefaultBuckets = []float64{0.0001, 0.0005, 0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// metricHelp là mô tả HELP của các metrics do application ghi nhận.
+var metricHelp = map[string]string{
+	MetricProviderRegisterDuration: "Duration of service provider Register calls in seconds.",
+	MetricProviderBootDuration:     "Duration of service provider Boot calls in seconds.",
+	MetricBootDuration:             "Duration of the last boot of all service providers in seconds.",
+	MetricResolutions:              "Number of container resolutions through Make and MustMake.",
+	MetricResolutionDuration:       "Duration of container resolutions in seconds.",
+	MetricResolutionFailures:       "Number of failed container resolutions.",
+	MetricLifecycleState:           "Current lifecycle state of the application (1 for the active state).",
+}
+
+// PrometheusMetrics là Metrics implementation lưu metrics trong bộ nhớ và xuất
+// theo Prometheus text exposition format, không cần dependency ngoài.
+//
+// PrometheusMetrics implement http.Handler nên có thể mount trực tiếp làm
+// endpoint "/metrics"; admin handler tự mount nó khi được cấu hình qua SetMetrics().
+type PrometheusMetrics struct {
+	mu       sync.Mutex
+	buckets  []float64
+	help     map[string]string
+	families map[string]*metricFamily
+}
+
+// metricFamily chứa các series cùng tên và cùng loại metric.
+type metricFamily struct {
+	kind   string
+	series map[string]*metricSeries
+}
+
+// metricSeries là giá trị của một metric với một tập labels.
+//
+// Fields:
+//   - labels: Labels đã format, ví dụ `abstract="config"`
+//   - value: Giá trị của counter hoặc gauge
+//   - counts: Số quan sát trong từng bucket của histogram (không cộng dồn)
+//   - sum: Tổng các giá trị quan sát của histogram
+//   - count: Số quan sát của histogram
+type metricSeries struct {
+	labels string
+	value  float64
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// NewPrometheusMetrics tạo PrometheusMetrics rỗng.
+//
+// Tham số:
+//   - buckets: ...float64 - Upper bounds tăng dần cho histograms, DefaultBuckets nếu không truyền
+//
+// Trả về:
+//   - *PrometheusMetrics: Metrics instance
+//
+// Ví dụ:
+//
+//	metrics := core.NewPrometheusMetrics()
+//	app.SetMetrics(metrics)
+//	http.Handle("/metrics", metrics)
+func NewPrometheusMetrics(buckets ...float64) *PrometheusMetrics {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+
+	help := make(map[string]string, len(metricHelp))
+	for name, text := range metricHelp {
+		help[name] = text
+	}
+	return &PrometheusMetrics{
+		buckets:  sorted,
+		help:     help,
+		families: make(map[string]*metricFamily),
+	}
+}
+
+// Describe đặt mô tả HELP cho metric.
+//
+// Tham số:
+//   - name: string - Tên metric
+//   - help: string - Mô tả metric
+func (m *PrometheusMetrics) Describe(name, help string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.help[name] = help
+}
+
+// AddCounter cộng delta vào counter.
+//
+// Implement Metrics interface method. Delta âm bị bỏ qua.
+func (m *PrometheusMetrics) AddCounter(name string, labels Labels, delta float64) {
+	if delta < 0 {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if series := m.series(name, "counter", labels); series != nil {
+		series.value += delta
+	}
+}
+
+// ObserveHistogram ghi nhận một giá trị vào histogram.
+//
+// Implement Metrics interface method.
+func (m *PrometheusMetrics) ObserveHistogram(name string, labels Labels, value float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	series := m.series(name, "histogram", labels)
+	if series == nil {
+		return
+	}
+	if series.counts == nil {
+		series.counts = make([]uint64, len(m.buckets))
+	}
+	for i, bound := range m.buckets {
+		if value <= bound {
+			series.counts[i]++
+			break
+		}
+	}
+	series.sum += value
+	series.count++
+}
+
+// SetGauge đặt giá trị hiện tại của gauge.
+//
+// Implement Metrics interface method.
+func (m *PrometheusMetrics) SetGauge(name string, labels Labels, value float64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if series := m.series(name, "gauge", labels); series != nil {
+		series.value = value
+	}
+}
+
+// series trả về series của metric, tạo mới nếu chưa có.
+//
+// Caller phải giữ m.mu.
+//
+// Trả về:
+//   - *metricSeries: Series, nil nếu metric đã được dùng với loại khác
+func (m *PrometheusMetrics) series(name, kind string, labels Labels) *metricSeries {
+	family, exists := m.families[name]
+	if !exists {
+		family = &metricFamily{kind: kind, series: make(map[string]*metricSeries)}
+		m.families[name] = family
+	}
+	if family.kind != kind {
+		return nil
+	}
+
+	key := formatLabels(labels)
+	series, exists := family.series[key]
+	if !exists {
+		series = &metricSeries{labels: key}
+		family.series[key] = series
+	}
+	return series
+}
+
+// familySnapshot là bản sao của một metric family dùng khi xuất metrics.
+type familySnapshot struct {
+	name    string
+	help    string
+	hasHelp bool
+	kind    string
+	series  []metricSeries // Sắp xếp theo labels
+}
+
+// snapshot sao chép tất cả metric families dưới m.mu.
+//
+// Trả về:
+//   - []familySnapshot: Families sắp xếp theo tên
+func (m *PrometheusMetrics) snapshot() []familySnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	families := make([]familySnapshot, 0, len(m.families))
+	for name, family := range m.families {
+		help, hasHelp := m.help[name]
+		snapshot := familySnapshot{
+			name:    name,
+			help:    help,
+			hasHelp: hasHelp,
+			kind:    family.kind,
+			series:  make([]metricSeries, 0, len(family.series)),
+		}
+		for _, series := range family.series {
+			copied := *series
+			if series.counts != nil {
+				copied.counts = append([]uint64(nil), series.counts...)
+			}
+			snapshot.series = append(snapshot.series, copied)
+		}
+		sort.Slice(snapshot.series, func(i, j int) bool {
+			return snapshot.series[i].labels < snapshot.series[j].labels
+		})
+		families = append(families, snapshot)
+	}
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].name < families[j].name
+	})
+	return families
+}
+
+// WriteTo ghi tất cả metrics theo Prometheus text exposition format.
+//
+// Metrics được sắp xếp theo tên, series được sắp xếp theo labels. Metrics được
+// sao chép trước khi ghi nên writer chậm không chặn việc ghi nhận metrics.
+//
+// Tham số:
+//   - w: io.Writer - Writer đích
+//
+// Trả về:
+//   - int64: Số bytes đã ghi
+//   - error: Lỗi ghi
+func (m *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	families := m.snapshot()
+
+	counter := &countingWriter{w: w}
+	out := bufio.NewWriter(counter)
+	for _, family := range families {
+		name := family.name
+		if family.hasHelp {
+			fmt.Fprintf(out, "# HELP %s %s\n", name, escapeHelp(family.help))
+		}
+		fmt.Fprintf(out, "# TYPE %s %s\n", name, family.kind)
+
+		for _, series := range family.series {
+			if family.kind != "histogram" {
+				fmt.Fprintf(out, "%s%s %s\n", name, wrapLabels(series.labels), formatFloat(series.value))
+				continue
+			}
+
+			var cumulative uint64
+			for i, bound := range m.buckets {
+				if series.counts != nil {
+					cumulative += series.counts[i]
+				}
+				fmt.Fprintf(out, "%s_bucket%s %d\n", name, wrapLabels(joinLabels(series.labels, `le="`+formatFloat(bound)+`"`)), cumulative)
+			}
+			fmt.Fprintf(out, "%s_bucket%s %d\n", name, wrapLabels(joinLabels(series.labels, `le="+Inf"`)), series.count)
+			fmt.Fprintf(out, "%s_sum%s %s\n", name, wrapLabels(series.labels), formatFloat(series.sum))
+			fmt.Fprintf(out, "%s_count%s %d\n", name, wrapLabels(series.labels), series.count)
+		}
+	}
+
+	err := out.Flush()
+	return counter.n, err
+}
+
+// ServeHTTP phục vụ metrics theo Prometheus text exposition format.
+//
+// Implement http.Handler interface method.
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = m.WriteTo(w)
+}
+
+// countingWriter đếm số bytes đã ghi vào writer bên dưới.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+// Write ghi p vào writer bên dưới và cộng số bytes đã ghi.
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// formatLabels format labels theo thứ tự tên, ví dụ `abstract="config",provider="x"`.
+//
+// Tham số:
+//   - labels: Labels - Labels cần format
+//
+// Trả về:
+//   - string: Labels đã format, rỗng nếu không có label
+func formatLabels(labels Labels) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, name+`="`+escapeLabelValue(labels[name])+`"`)
+	}
+	return strings.Join(pairs, ",")
+}
+
+// joinLabels nối thêm một label đã format vào labels.
+func joinLabels(labels, extra string) string {
+	if labels == "" {
+		return extra
+	}
+	return labels + "," + extra
+}
+
+// wrapLabels bọc labels trong dấu ngoặc nhọn, rỗng nếu không có label.
+func wrapLabels(labels string) string {
+	if labels == "" {
+		return ""
+	}
+	return "{" + labels + "}"
+}
+
+// escapeLabelValue escape backslash, dấu nháy kép và xuống dòng trong label value.
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// escapeHelp escape backslash và xuống dòng trong HELP.
+func escapeHelp(help string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help)
+}
+
+// formatFloat format giá trị theo Prometheus text format.
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
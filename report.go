@@ -0,0 +1,404 @@
+package core
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"go.fork.vn/di"
+	"go.fork.vn/log"
+)
+
+// BootReport chứa thông tin profiling của quá trình register và boot service providers.
+//
+// Report được ghi nhận bởi RegisterWithDependencies(), RegisterServiceProviders()
+// và BootServiceProviders(), giúp xác định provider nào làm chậm quá trình startup.
+//
+// Allocations chỉ được đo khi config "app.boot.track_allocations" được bật, vì
+// runtime.ReadMemStats dừng toàn bộ goroutines trong lúc đọc. Số liệu được đo
+// bằng runtime.MemStats của toàn process, nên trong parallel boot mode chỉ mang
+// tính tương đối vì các providers boot đồng thời.
+type BootReport struct {
+	// StartedAt là thời điểm bắt đầu register providers
+	StartedAt time.Time
+	// RegisterDuration là tổng thời gian register tất cả providers
+	RegisterDuration time.Duration
+	// BootDuration là tổng thời gian boot tất cả providers
+	BootDuration time.Duration
+	// Parallel cho biết providers được boot ở parallel mode
+	Parallel bool
+	// Providers chứa timing của từng provider theo thứ tự register
+	Providers []ProviderTiming
+	// CriticalPath là chuỗi providers phụ thuộc nhau có tổng thời gian lớn nhất
+	CriticalPath []string
+	// CriticalPathDuration là tổng thời gian register và boot của critical path
+	CriticalPathDuration time.Duration
+}
+
+// ProviderTiming chứa timing và allocations của một provider.
+type ProviderTiming struct {
+	// Name là type name của provider
+	Name string
+	// Level là dependency level, -1 nếu không đăng ký qua RegisterWithDependencies()
+	Level int
+	// DependsOn là tên các providers cung cấp services mà provider này yêu cầu
+	DependsOn []string
+	// RegisterDuration là thời gian chạy Register
+	RegisterDuration time.Duration
+	// BootDuration là thời gian chạy Boot
+	BootDuration time.Duration
+	// RegisterAllocBytes là số bytes được allocate trong Register, 0 nếu không đo allocations
+	RegisterAllocBytes uint64
+	// BootAllocBytes là số bytes được allocate trong Boot, 0 nếu không đo allocations
+	BootAllocBytes uint64
+	// RegisterAllocs là số lần allocate trong Register, 0 nếu không đo allocations
+	RegisterAllocs uint64
+	// BootAllocs là số lần allocate trong Boot, 0 nếu không đo allocations
+	BootAllocs uint64
+	// Booted cho biết provider đã boot thành công
+	Booted bool
+	// BootOrder là thứ tự provider boot xong, bắt đầu từ 1; 0 nếu chưa boot thành công
+	BootOrder int
+}
+
+// Total trả về tổng thời gian register và boot của provider.
+//
+// Trả về:
+//   - time.Duration: RegisterDuration + BootDuration
+func (t ProviderTiming) Total() time.Duration {
+	return t.RegisterDuration + t.BootDuration
+}
+
+// Table trả về report dưới dạng bảng text dễ đọc.
+//
+// Trả về:
+//   - string: Bảng timing của từng provider và critical path
+func (r *BootReport) Table() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "boot report: register=%s boot=%s parallel=%t\n", r.RegisterDuration, r.BootDuration, r.Parallel)
+
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROVIDER\tLEVEL\tREGISTER\tBOOT\tALLOC (REGISTER/BOOT)\tALLOCS (REGISTER/BOOT)")
+	for _, timing := range r.Providers {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%d/%d B\t%d/%d\n",
+			timing.Name, timing.Level,
+			timing.RegisterDuration, timing.BootDuration,
+			timing.RegisterAllocBytes, timing.BootAllocBytes,
+			timing.RegisterAllocs, timing.BootAllocs)
+	}
+	w.Flush()
+
+	if len(r.CriticalPath) > 0 {
+		fmt.Fprintf(&b, "critical path (%s): %s\n", r.CriticalPathDuration, strings.Join(r.CriticalPath, " -> "))
+	}
+
+	return b.String()
+}
+
+// bootRecorder ghi nhận timing của quá trình register và boot.
+//
+// Recorder an toàn khi dùng đồng thời từ nhiều goroutines (parallel boot mode).
+type bootRecorder struct {
+	mu           sync.Mutex
+	startedAt    time.Time
+	registerTime time.Duration
+	bootTime     time.Duration
+	parallel     bool
+	booted       int
+	order        []string
+	timings      map[string]*ProviderTiming
+}
+
+// newBootRecorder tạo recorder rỗng.
+//
+// Trả về:
+//   - *bootRecorder: Recorder instance
+func newBootRecorder() *bootRecorder {
+	return &bootRecorder{
+		order:   make([]string, 0),
+		timings: make(map[string]*ProviderTiming),
+	}
+}
+
+// timing trả về timing entry của provider, tạo mới nếu chưa có.
+//
+// Caller phải giữ r.mu.
+func (r *bootRecorder) timing(provider di.ServiceProvider) *ProviderTiming {
+	key := getProviderKey(provider)
+	timing, exists := r.timings[key]
+	if !exists {
+		timing = &ProviderTiming{Name: providerName(provider), Level: -1}
+		r.timings[key] = timing
+		r.order = append(r.order, key)
+	}
+	return timing
+}
+
+// measure chạy fn và trả về thời gian chạy cùng allocations.
+//
+// Tham số:
+//   - fn: func() - Hàm cần đo
+//   - allocations: bool - Đo allocations qua runtime.ReadMemStats (stop-the-world)
+//
+// Trả về:
+//   - time.Duration: Thời gian chạy
+//   - uint64: Số bytes được allocate, 0 nếu không đo allocations
+//   - uint64: Số lần allocate, 0 nếu không đo allocations
+func measure(fn func(), allocations bool) (time.Duration, uint64, uint64) {
+	if !allocations {
+		start := time.Now()
+		fn()
+		return time.Since(start), 0, 0
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+
+	fn()
+
+	elapsed := time.Since(start)
+	runtime.ReadMemStats(&after)
+	return elapsed, after.TotalAlloc - before.TotalAlloc, after.Mallocs - before.Mallocs
+}
+
+// registerProvider gọi Register của provider và ghi nhận timing.
+//
+// Tham số:
+//   - app: di.Application - Application truyền vào Register
+//   - provider: di.ServiceProvider - Provider cần register
+//   - allocations: bool - Đo allocations của Register
+//
+// Trả về:
+//   - time.Duration: Thời gian Register
+func (r *bootRecorder) registerProvider(app di.Application, provider di.ServiceProvider, allocations bool) time.Duration {
+	r.mu.Lock()
+	if r.startedAt.IsZero() {
+		r.startedAt = time.Now()
+	}
+	r.mu.Unlock()
+
+	elapsed, bytes, allocs := measure(func() {
+		provider.Register(app)
+	}, allocations)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	timing := r.timing(provider)
+	timing.RegisterDuration = elapsed
+	timing.RegisterAllocBytes = bytes
+	timing.RegisterAllocs = allocs
+	r.registerTime += elapsed
+	return elapsed
+}
+
+// recordBoot ghi nhận timing boot của provider.
+//
+// Tham số:
+//   - provider: di.ServiceProvider - Provider đã boot
+//   - elapsed: time.Duration - Thời gian boot
+//   - bytes: uint64 - Số bytes được allocate
+//   - allocs: uint64 - Số lần allocate
+//   - booted: bool - Provider boot thành công
+func (r *bootRecorder) recordBoot(provider di.ServiceProvider, elapsed time.Duration, bytes, allocs uint64, booted bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	timing := r.timing(provider)
+	timing.BootDuration = elapsed
+	timing.BootAllocBytes = bytes
+	timing.BootAllocs = allocs
+	timing.Booted = booted
+	if booted {
+		r.booted++
+		timing.BootOrder = r.booted
+	}
+}
+
+// isBooted kiểm tra provider đã boot thành công chưa.
+//
+// Tham số:
+//   - provider: di.ServiceProvider - Provider cần kiểm tra
+//
+// Trả về:
+//   - bool: true nếu provider đã boot thành công
+func (r *bootRecorder) isBooted(provider di.ServiceProvider) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	timing, exists := r.timings[getProviderKey(provider)]
+	return exists && timing.Booted
+}
+
+// forgetProvider xóa timing của provider đã bị gỡ khỏi application.
+//
+// Tham số:
+//   - provider: di.ServiceProvider - Provider đã bị gỡ
+func (r *bootRecorder) forgetProvider(provider di.ServiceProvider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := getProviderKey(provider)
+	delete(r.timings, key)
+	for i, ordered := range r.order {
+		if ordered == key {
+			r.order = append(r.order[:i:i], r.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// finishBoot ghi nhận tổng thời gian boot.
+//
+// Tham số:
+//   - elapsed: time.Duration - Tổng thời gian boot
+//   - parallel: bool - Boot ở parallel mode
+func (r *bootRecorder) finishBoot(elapsed time.Duration, parallel bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bootTime = elapsed
+	r.parallel = parallel
+}
+
+// BootReport trả về report timing của quá trình register và boot.
+//
+// Implement Application interface method.
+//
+// Trả về:
+//   - *BootReport: Snapshot của report tại thời điểm gọi
+func (a *application) BootReport() *BootReport {
+	r := a.recorder
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	report := &BootReport{
+		StartedAt:        r.startedAt,
+		RegisterDuration: r.registerTime,
+		BootDuration:     r.bootTime,
+		Parallel:         r.parallel,
+		Providers:        make([]ProviderTiming, 0, len(r.order)),
+	}
+
+	levels := make(map[string]int)
+	for index, level := range a.providerLevels() {
+		for _, provider := range level {
+			levels[getProviderKey(provider)] = index
+		}
+	}
+
+	dependencies := newProviderGraph(a.ServiceProviders()).dependsOn
+	for _, key := range r.order {
+		timing := *r.timings[key]
+		if level, ok := levels[key]; ok {
+			timing.Level = level
+		}
+		for _, dependency := range dependencies[key] {
+			if dependencyTiming, exists := r.timings[dependency]; exists {
+				timing.DependsOn = append(timing.DependsOn, dependencyTiming.Name)
+			}
+		}
+		report.Providers = append(report.Providers, timing)
+	}
+
+	report.CriticalPath, report.CriticalPathDuration = r.criticalPath(a.bootOrder(), dependencies)
+	return report
+}
+
+// criticalPath tìm chuỗi dependency có tổng thời gian register và boot lớn nhất.
+//
+// Caller phải giữ r.mu.
+//
+// Tham số:
+//   - sorted: []di.ServiceProvider - Providers theo dependency order
+//   - dependencies: map[string][]string - Dependency edges giữa các providers
+//
+// Trả về:
+//   - []string: Tên providers trên critical path, theo thứ tự boot
+//   - time.Duration: Tổng thời gian của critical path
+func (r *bootRecorder) criticalPath(sorted []di.ServiceProvider, dependencies map[string][]string) ([]string, time.Duration) {
+	distance := make(map[string]time.Duration)
+	previous := make(map[string]string)
+
+	var last string
+	var longest time.Duration
+	for _, provider := range sorted {
+		key := getProviderKey(provider)
+		timing, exists := r.timings[key]
+		if !exists {
+			continue
+		}
+
+		var best time.Duration
+		for _, dependency := range dependencies[key] {
+			if d, ok := distance[dependency]; ok && d >= best {
+				best = d
+				previous[key] = dependency
+			}
+		}
+
+		distance[key] = best + timing.Total()
+		if last == "" || distance[key] > longest {
+			last = key
+			longest = distance[key]
+		}
+	}
+
+	if last == "" {
+		return nil, 0
+	}
+
+	path := make([]string, 0)
+	for key := last; key != ""; key = previous[key] {
+		path = append([]string{r.timings[key].Name}, path...)
+	}
+	return path, longest
+}
+
+// trackAllocations kiểm tra config "app.boot.track_allocations" có được bật không.
+//
+// Trả về:
+//   - bool: true nếu cần đo allocations khi register và boot providers
+func (a *application) trackAllocations() bool {
+	cfg, ok := a.configManager()
+	if !ok {
+		return false
+	}
+	track, ok := cfg.GetBool("app.boot.track_allocations")
+	return ok && track
+}
+
+// logBootReport log report dưới dạng bảng khi config "app.debug" được bật.
+//
+// Không làm gì nếu config hoặc log manager chưa được đăng ký.
+func (a *application) logBootReport() {
+	cfg, ok := a.configManager()
+	if !ok {
+		return
+	}
+	if debug, ok := cfg.GetBool("app.debug"); !ok || !debug {
+		return
+	}
+	logger, ok := a.logManager()
+	if !ok {
+		return
+	}
+	logger.Info("%s", a.BootReport().Table())
+}
+
+// logManager trả về log manager nếu đã được đăng ký vào container.
+//
+// Khác với Log(), phương thức này không panic khi log manager chưa được đăng ký.
+//
+// Trả về:
+//   - log.Manager: Log manager instance
+//   - bool: true nếu log manager khả dụng
+func (a *application) logManager() (log.Manager, bool) {
+	instance, err := a.container.Make("log")
+	if err != nil {
+		return nil, false
+	}
+	logger, ok := instance.(log.Manager)
+	return logger, ok
+}
@@ -0,0 +1,193 @@
+package core_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+	"go.fork.vn/di"
+	logMocks "go.fork.vn/log/mocks"
+)
+
+// sleepingProvider tạo contextProvider boot trong khoảng thời gian cho trước.
+func sleepingProvider(provides string, requires []string, duration time.Duration) *contextProvider {
+	return &contextProvider{
+		provides: []string{provides},
+		requires: requires,
+		boot: func(ctx context.Context, app di.Application) error {
+			time.Sleep(duration)
+			return nil
+		},
+	}
+}
+
+// TestApplication_BootReport tests boot timing and profiling report
+func TestApplication_BootReport(t *testing.T) {
+	t.Run("empty_report_before_boot", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		report := app.BootReport()
+		require.NotNil(t, report)
+		assert.Empty(t, report.Providers)
+		assert.Empty(t, report.CriticalPath)
+	})
+
+	t.Run("records_register_and_boot_timing_per_provider", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+
+		providerA := sleepingProvider("service.a", nil, 5*time.Millisecond)
+		providerB := sleepingProvider("service.b", []string{"service.a"}, 5*time.Millisecond)
+		app.Register(providerB)
+		app.Register(providerA)
+
+		require.NoError(t, app.Boot())
+
+		report := app.BootReport()
+		require.Len(t, report.Providers, 2)
+		assert.False(t, report.StartedAt.IsZero())
+		assert.GreaterOrEqual(t, report.BootDuration, 10*time.Millisecond)
+
+		first, second := report.Providers[0], report.Providers[1]
+		assert.Equal(t, 0, first.Level)
+		assert.Equal(t, 1, second.Level)
+		assert.Equal(t, []string{"*core_test.contextProvider"}, second.DependsOn)
+		assert.GreaterOrEqual(t, first.BootDuration, 5*time.Millisecond)
+		assert.GreaterOrEqual(t, second.BootDuration, 5*time.Millisecond)
+		assert.True(t, first.Booted)
+		assert.True(t, second.Booted)
+	})
+
+	t.Run("computes_critical_path_through_dependency_graph", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{
+			"app.boot.parallel": true,
+		})
+
+		app.Register(sleepingProvider("database", nil, 20*time.Millisecond))
+		app.Register(sleepingProvider("cache", nil, 2*time.Millisecond))
+		app.Register(sleepingProvider("repository", []string{"database"}, 20*time.Millisecond))
+
+		require.NoError(t, app.RegisterWithDependencies())
+		require.NoError(t, app.BootServiceProviders())
+
+		report := app.BootReport()
+		assert.True(t, report.Parallel)
+		assert.Len(t, report.CriticalPath, 2)
+		assert.GreaterOrEqual(t, report.CriticalPathDuration, 40*time.Millisecond)
+	})
+
+	t.Run("marks_failed_provider_as_not_booted", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(&contextProvider{
+			provides: []string{"service"},
+			boot: func(ctx context.Context, app di.Application) error {
+				return assert.AnError
+			},
+		})
+
+		assert.Error(t, app.Boot())
+
+		report := app.BootReport()
+		require.Len(t, report.Providers, 1)
+		assert.False(t, report.Providers[0].Booted)
+	})
+
+	t.Run("tracks_allocations_only_when_enabled", func(t *testing.T) {
+		t.Parallel()
+
+		var sink []byte
+		allocating := func() *contextProvider {
+			return &contextProvider{
+				provides: []string{"buffer"},
+				boot: func(ctx context.Context, app di.Application) error {
+					sink = make([]byte, 1<<20)
+					return nil
+				},
+			}
+		}
+
+		app := core.New(map[string]interface{}{})
+		app.Register(allocating())
+		require.NoError(t, app.Boot())
+		assert.Zero(t, app.BootReport().Providers[0].BootAllocBytes)
+
+		tracked := newConfiguredApp(t, map[string]interface{}{
+			"app.boot.track_allocations": true,
+		})
+		tracked.Register(allocating())
+		require.NoError(t, tracked.Boot())
+		assert.GreaterOrEqual(t, tracked.BootReport().Providers[0].BootAllocBytes, uint64(1<<20))
+		assert.NotNil(t, sink)
+	})
+
+	t.Run("table_lists_providers_and_critical_path", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(sleepingProvider("service", nil, time.Millisecond))
+
+		require.NoError(t, app.Boot())
+
+		table := app.BootReport().Table()
+		assert.Contains(t, table, "PROVIDER")
+		assert.Contains(t, table, "*core_test.contextProvider")
+		assert.Contains(t, table, "critical path")
+	})
+}
+
+// TestApplication_BootReport_Logging tests debug logging of the boot report
+func TestApplication_BootReport_Logging(t *testing.T) {
+	t.Run("logs_table_when_debug_enabled", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{
+			"app.debug": true,
+		})
+
+		mockLog := logMocks.NewMockManager(t)
+		mockLog.EXPECT().Info("%s", mock.MatchedBy(func(table string) bool {
+			return strings.Contains(table, "boot report")
+		})).Once()
+		expectLifecycleEvents(mockLog)
+		app.Instance("log", mockLog)
+
+		app.Register(sleepingProvider("service", nil, 0))
+		assert.NoError(t, app.Boot())
+	})
+
+	t.Run("does_not_log_when_debug_disabled", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{
+			"app.debug": false,
+		})
+
+		mockLog := logMocks.NewMockManager(t)
+		expectLifecycleEvents(mockLog)
+		app.Instance("log", mockLog)
+
+		app.Register(sleepingProvider("service", nil, 0))
+		assert.NoError(t, app.Boot())
+	})
+}
+
+// expectLifecycleEvents cho phép mock log manager nhận lifecycle events của register và boot.
+func expectLifecycleEvents(mockLog *logMocks.MockManager) {
+	isEvent := mock.MatchedBy(func(message string) bool {
+		return strings.HasPrefix(message, "lifecycle event=")
+	})
+	mockLog.EXPECT().Debug("%s", isEvent).Maybe()
+	mockLog.EXPECT().Info("%s", isEvent).Maybe()
+}
@@ -0,0 +1,257 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// semver là semantic version đã parse (MAJOR.MINOR.PATCH-PRERELEASE).
+type semver struct {
+	major, minor, patch int
+	prerelease          []string
+}
+
+// parseSemver parse semantic version, chấp nhận tiền tố "v" và build metadata.
+//
+// Tham số:
+//   - value: string - Version, ví dụ "v1.2.3", "1.2.3-beta.1+build.5"
+//
+// Trả về:
+//   - semver: Version đã parse
+//   - error: Lỗi nếu version không hợp lệ
+func parseSemver(value string) (semver, error) {
+	return parseVersion(value, false)
+}
+
+// parseVersion parse version; partial cho phép bỏ MINOR và PATCH (mặc định 0).
+//
+// Tham số:
+//   - value: string - Version cần parse
+//   - partial: bool - Cho phép version dạng "1" hoặc "1.2"
+//
+// Trả về:
+//   - semver: Version đã parse
+//   - error: Lỗi nếu version không hợp lệ
+func parseVersion(value string, partial bool) (semver, error) {
+	text := strings.TrimPrefix(strings.TrimSpace(value), "v")
+	if index := strings.IndexByte(text, '+'); index >= 0 {
+		text = text[:index]
+	}
+
+	var v semver
+	if index := strings.IndexByte(text, '-'); index >= 0 {
+		v.prerelease = strings.Split(text[index+1:], ".")
+		text = text[:index]
+	}
+
+	parts := strings.Split(text, ".")
+	if len(parts) > 3 || (!partial && len(parts) != 3) {
+		return semver{}, fmt.Errorf("invalid semantic version '%s'", value)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil || number < 0 {
+			return semver{}, fmt.Errorf("invalid semantic version '%s'", value)
+		}
+		numbers[i] = number
+	}
+	v.major, v.minor, v.patch = numbers[0], numbers[1], numbers[2]
+	return v, nil
+}
+
+// compare so sánh hai versions theo thứ tự semantic versioning.
+//
+// Tham số:
+//   - other: semver - Version cần so sánh
+//
+// Trả về:
+//   - int: -1 nếu v < other, 0 nếu bằng nhau, 1 nếu v > other
+func (v semver) compare(other semver) int {
+	for _, pair := range [][2]int{{v.major, other.major}, {v.minor, other.minor}, {v.patch, other.patch}} {
+		if pair[0] != pair[1] {
+			return compareInts(pair[0], pair[1])
+		}
+	}
+
+	// Version không có prerelease lớn hơn version có prerelease
+	switch {
+	case len(v.prerelease) == 0 && len(other.prerelease) == 0:
+		return 0
+	case len(v.prerelease) == 0:
+		return 1
+	case len(other.prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.prerelease) && i < len(other.prerelease); i++ {
+		a, b := v.prerelease[i], other.prerelease[i]
+		if a == b {
+			continue
+		}
+		aNumber, aErr := strconv.Atoi(a)
+		bNumber, bErr := strconv.Atoi(b)
+		switch {
+		case aErr == nil && bErr == nil:
+			return compareInts(aNumber, bNumber)
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		case a < b:
+			return -1
+		default:
+			return 1
+		}
+	}
+	return compareInts(len(v.prerelease), len(other.prerelease))
+}
+
+// compareInts so sánh hai số nguyên.
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// versionConstraint là điều kiện version đã parse, gồm các điều kiện con phải cùng thỏa mãn.
+type versionConstraint []versionTerm
+
+// versionTerm là một điều kiện con của constraint.
+type versionTerm struct {
+	version semver
+	check   func(semver) bool
+}
+
+// constraintOperators là các ký tự tạo thành toán tử của constraint.
+const constraintOperators = "=!<>^~"
+
+// parseConstraint parse version constraint.
+//
+// Hỗ trợ các toán tử =, !=, >, >=, <, <=, ^ và ~. ^ cho phép thay đổi không
+// làm đổi phần khác 0 đầu tiên (^1.2.3 là <2.0.0, ^0.2.3 là <0.3.0, ^0.0.3 là
+// <0.0.4); ~ cho phép thay đổi patch, hoặc minor khi chỉ có major (~1 là
+// <2.0.0). Toán tử có thể cách version bằng khoảng trắng (">= 1.0.0"). Nhiều
+// điều kiện phân cách bằng dấu phẩy hoặc khoảng trắng phải cùng thỏa mãn.
+// Constraint rỗng hoặc "*" chấp nhận mọi version.
+//
+// Tham số:
+//   - value: string - Constraint, ví dụ "^1.2.0", ">=1.0.0, <2.0.0"
+//
+// Trả về:
+//   - versionConstraint: Constraint đã parse
+//   - error: Lỗi nếu constraint không hợp lệ
+func parseConstraint(value string) (versionConstraint, error) {
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+
+	// Ghép toán tử đứng riêng với version phía sau
+	terms := make([]string, 0, len(fields))
+	for i := 0; i < len(fields); i++ {
+		term := fields[i]
+		if strings.Trim(term, constraintOperators) == "" && term != "" && i+1 < len(fields) {
+			i++
+			term += fields[i]
+		}
+		terms = append(terms, term)
+	}
+
+	constraint := make(versionConstraint, 0, len(terms))
+	for _, term := range terms {
+		if term == "*" {
+			continue
+		}
+
+		text := strings.TrimLeft(term, constraintOperators)
+		operator := term[:len(term)-len(text)]
+		version, err := parseVersion(text, true)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint '%s'", value)
+		}
+		precision := versionPrecision(text)
+
+		var check func(semver) bool
+		switch operator {
+		case "", "=", "==":
+			check = func(v semver) bool { return v.compare(version) == 0 }
+		case "!=":
+			check = func(v semver) bool { return v.compare(version) != 0 }
+		case ">":
+			check = func(v semver) bool { return v.compare(version) > 0 }
+		case ">=":
+			check = func(v semver) bool { return v.compare(version) >= 0 }
+		case "<":
+			check = func(v semver) bool { return v.compare(version) < 0 }
+		case "<=":
+			check = func(v semver) bool { return v.compare(version) <= 0 }
+		case "^":
+			var upper semver
+			switch {
+			case version.major > 0 || precision == 1:
+				upper = semver{major: version.major + 1}
+			case version.minor > 0 || precision == 2:
+				upper = semver{minor: version.minor + 1}
+			default:
+				upper = semver{patch: version.patch + 1}
+			}
+			check = func(v semver) bool { return v.compare(version) >= 0 && v.compare(upper) < 0 }
+		case "~":
+			upper := semver{major: version.major, minor: version.minor + 1}
+			if precision == 1 {
+				upper = semver{major: version.major + 1}
+			}
+			check = func(v semver) bool { return v.compare(version) >= 0 && v.compare(upper) < 0 }
+		default:
+			return nil, fmt.Errorf("invalid version constraint '%s'", value)
+		}
+		constraint = append(constraint, versionTerm{version: version, check: check})
+	}
+	return constraint, nil
+}
+
+// versionPrecision đếm số thành phần MAJOR.MINOR.PATCH có trong version.
+//
+// Tham số:
+//   - text: string - Version, có thể thiếu MINOR và PATCH
+//
+// Trả về:
+//   - int: 1, 2 hoặc 3
+func versionPrecision(text string) int {
+	if index := strings.IndexAny(text, "-+"); index >= 0 {
+		text = text[:index]
+	}
+	return strings.Count(text, ".") + 1
+}
+
+// allows kiểm tra version thỏa mãn tất cả điều kiện của constraint.
+//
+// Version prerelease chỉ thỏa mãn khi constraint có điều kiện với prerelease
+// cùng MAJOR.MINOR.PATCH, nên "2.0.0-beta" không thỏa mãn "^1.2.0" còn
+// "1.3.0-rc.2" thỏa mãn ">=1.3.0-rc.1". Constraint rỗng chấp nhận mọi version.
+//
+// Tham số:
+//   - version: semver - Version cần kiểm tra
+//
+// Trả về:
+//   - bool: true nếu version thỏa mãn constraint
+func (c versionConstraint) allows(version semver) bool {
+	prereleaseAllowed := len(version.prerelease) == 0 || len(c) == 0
+	for _, term := range c {
+		if !term.check(version) {
+			return false
+		}
+		if len(term.version.prerelease) > 0 && term.version.major == version.major &&
+			term.version.minor == version.minor && term.version.patch == version.patch {
+			prereleaseAllowed = true
+		}
+	}
+	return prereleaseAllowed
+}
@@ -0,0 +1,138 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.fork.vn/di"
+)
+
+// UnboundService mô tả service được khai báo trong Providers() nhưng không được bind khi Register.
+type UnboundService struct {
+	// Provider là tên (type) của provider khai báo service
+	Provider string
+	// Service là tên service không được bind
+	Service string
+}
+
+// String trả về mô tả một dòng của unbound service.
+//
+// Trả về:
+//   - string: Mô tả provider và service
+func (u UnboundService) String() string {
+	return fmt.Sprintf("service provider %s declares service '%s' but does not bind it during Register", u.Provider, u.Service)
+}
+
+// UnboundServicesError chứa tất cả services được khai báo nhưng không được bind ở strict mode.
+type UnboundServicesError struct {
+	// Unbound là danh sách services theo thứ tự đăng ký của providers
+	Unbound []UnboundService
+}
+
+// Error implement error interface.
+//
+// Trả về:
+//   - string: Mô tả unbound service duy nhất, hoặc danh sách tất cả trên từng dòng
+func (e *UnboundServicesError) Error() string {
+	if len(e.Unbound) == 1 {
+		return e.Unbound[0].String()
+	}
+
+	lines := make([]string, 0, len(e.Unbound)+1)
+	lines = append(lines, fmt.Sprintf("%d declared services are not bound during Register:", len(e.Unbound)))
+	for _, unbound := range e.Unbound {
+		lines = append(lines, "  - "+unbound.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// BindingsDuring chạy fn và trả về các services được bind vào container của app trong lúc đó.
+//
+// Bindings được so sánh trên container trước và sau fn, nên bindings qua
+// application lẫn qua Container() trực tiếp đều được ghi nhận, kể cả khi ghi
+// đè binding đã có. Bindings từ goroutines khác chạy đồng thời cũng được tính.
+//
+// Tham số:
+//   - app: Application - Application tạo bởi New()
+//   - fn: func() - Thao tác cần theo dõi, ví dụ Register của provider
+//
+// Trả về:
+//   - []string: Services được bind, theo thứ tự bind lần cuối
+//   - error: Lỗi nếu container của app không phải container của core
+//
+// Ví dụ:
+//
+//	bound, err := core.BindingsDuring(app, func() { provider.Register(app) })
+func BindingsDuring(app Application, fn func()) ([]string, error) {
+	container, ok := app.Container().(*bindingContainer)
+	if !ok {
+		return nil, fmt.Errorf("cannot track bindings of %T: container was not created by core.New", app)
+	}
+
+	revision := container.currentRevision()
+	fn()
+	return container.boundSince(revision), nil
+}
+
+// registerStrict register provider và so sánh bindings với Providers().
+//
+// Bindings của container trước và sau Register được so sánh, nên services bind
+// qua application lẫn qua Container() trực tiếp đều được tính. Bindings ngoài
+// Providers() được log warning qua Log() nếu log manager khả dụng.
+//
+// Tham số:
+//   - ctx: context.Context - Context chứa span cha của span register
+//   - provider: di.ServiceProvider - Provider cần register
+//
+// Trả về:
+//   - []UnboundService: Services được khai báo nhưng không được bind
+func (a *application) registerStrict(ctx context.Context, provider di.ServiceProvider) []UnboundService {
+	revision := a.container.currentRevision()
+	a.registerProvider(ctx, a, provider)
+
+	bound := make(map[string]bool)
+	for _, service := range a.container.boundSince(revision) {
+		bound[service] = true
+	}
+
+	name := providerName(provider)
+	unbound := make([]UnboundService, 0)
+	isDeclared := make(map[string]bool)
+	for _, service := range provider.Providers() {
+		isDeclared[service] = true
+		if !bound[service] {
+			unbound = append(unbound, UnboundService{Provider: name, Service: service})
+		}
+	}
+
+	undeclared := make([]string, 0)
+	for service := range bound {
+		if !isDeclared[service] {
+			undeclared = append(undeclared, service)
+		}
+	}
+	if len(undeclared) > 0 {
+		if logger, ok := a.logManager(); ok {
+			sort.Strings(undeclared)
+			logger.Warning("service provider %s binds undeclared services %s; add them to Providers()",
+				name, quoteServices(undeclared))
+		}
+	}
+
+	return unbound
+}
+
+// strictProviders đọc config "app.providers.strict".
+//
+// Trả về:
+//   - bool: true nếu RegisterWithDependencies() cần kiểm tra bindings của providers
+func (a *application) strictProviders() bool {
+	cfg, ok := a.configManager()
+	if !ok {
+		return false
+	}
+	strict, ok := cfg.GetBool("app.providers.strict")
+	return ok && strict
+}
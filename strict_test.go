@@ -0,0 +1,128 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+	"go.fork.vn/core/coretest"
+	"go.fork.vn/core/mocks"
+	"go.fork.vn/di"
+)
+
+// registeringProvider là lifecycleProvider bind services qua register khi Register.
+type registeringProvider struct {
+	lifecycleProvider
+	register func(app di.Application)
+}
+
+func (p *registeringProvider) Register(app di.Application) {
+	p.register(app)
+}
+
+// TestApplication_StrictProviders tests verification of declared services in strict mode
+func TestApplication_StrictProviders(t *testing.T) {
+	t.Run("rejects_declared_but_unbound_services", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{"app.providers.strict": true})
+		app.Register(newBindingProvider("database", "primary"))
+		app.Register(newLifecycleProvider("cache"))
+		app.Register(newLifecycleProvider("queue", "cache"))
+
+		err := app.RegisterWithDependencies()
+
+		var unboundErr *core.UnboundServicesError
+		require.True(t, errors.As(err, &unboundErr))
+		assert.Equal(t, []core.UnboundService{
+			{Provider: "*core_test.lifecycleProvider", Service: "cache"},
+			{Provider: "*core_test.lifecycleProvider", Service: "queue"},
+		}, unboundErr.Unbound)
+		assert.Contains(t, err.Error(), "2 declared services are not bound during Register:")
+	})
+
+	t.Run("accepts_providers_binding_declared_services", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{"app.providers.strict": true})
+		app.Register(newBindingProvider("database", "primary"))
+		app.Register(newBindingProvider("repository", "repository", "database"))
+
+		require.NoError(t, app.RegisterWithDependencies())
+		assert.Equal(t, "repository", app.MustMake("repository"))
+	})
+
+	t.Run("counts_bindings_through_container", func(t *testing.T) {
+		t.Parallel()
+
+		app := newConfiguredApp(t, map[string]interface{}{"app.providers.strict": true})
+		app.Register(&registeringProvider{
+			lifecycleProvider: *newLifecycleProvider("cache"),
+			register: func(app di.Application) {
+				app.Container().Instance("cache", "redis")
+			},
+		})
+
+		require.NoError(t, app.RegisterWithDependencies())
+	})
+
+	t.Run("warns_about_undeclared_bindings", func(t *testing.T) {
+		t.Parallel()
+
+		logs := &coretest.LogRecorder{}
+		app := newConfiguredApp(t, map[string]interface{}{"app.providers.strict": true})
+		app.Instance("log", logs)
+		app.Register(&registeringProvider{
+			lifecycleProvider: *newLifecycleProvider("cache"),
+			register: func(app di.Application) {
+				app.Instance("cache", "redis")
+				app.Container().Instance("cache.store", "redis")
+				app.Alias("cache", "cache.default")
+			},
+		})
+
+		require.NoError(t, app.RegisterWithDependencies())
+		assert.True(t, logs.Contains("warning",
+			`service provider *core_test.registeringProvider binds undeclared services 'cache.default', 'cache.store'; add them to Providers()`))
+	})
+
+	t.Run("skips_verification_by_default", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Register(newLifecycleProvider("cache"))
+
+		assert.NoError(t, app.RegisterWithDependencies())
+	})
+}
+
+// TestBindingsDuring tests tracking bindings made while a function runs
+func TestBindingsDuring(t *testing.T) {
+	t.Run("returns_bindings_in_order", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		app.Instance("database", "primary")
+
+		bound, err := core.BindingsDuring(app, func() {
+			app.Instance("cache", "redis")
+			app.Container().Bind("queue", func(c di.Container) interface{} { return "sqs" })
+			app.Instance("database", "replica")
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"cache", "queue", "database"}, bound)
+	})
+
+	t.Run("rejects_foreign_container", func(t *testing.T) {
+		t.Parallel()
+
+		app := mocks.NewMockApplication(t)
+		app.EXPECT().Container().Return(di.New())
+
+		_, err := core.BindingsDuring(app, func() {})
+		assert.ErrorContains(t, err, "container was not created by core.New")
+	})
+}
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"fmt"
+	"sync"
+)
+
+// BindingSwap là binding đã bị thay bởi Application.Swap().
+type BindingSwap struct {
+	// Abstract là tên service bị thay
+	Abstract string
+	// Stale là các services có factory đã resolve Abstract trước khi swap (trực
+	// tiếp hoặc gián tiếp qua services khác), gần Abstract nhất trước; chúng có
+	// thể vẫn giữ reference tới instance cũ
+	Stale []string
+
+	once    sync.Once
+	restore func()
+}
+
+// Restore khôi phục binding gốc của service. Gọi nhiều lần chỉ khôi phục một lần.
+func (s *BindingSwap) Restore() {
+	s.once.Do(s.restore)
+}
+
+// Swap thay binding của service bằng implementation khác.
+//
+// Implement Application interface method.
+//
+// Service không được resolve khi swap. Restore() ghi lại định nghĩa binding
+// gốc: transient bindings vẫn tạo instance mới mỗi lần resolve, singletons
+// chưa resolve vẫn resolve lazy và singletons đã resolve giữ instance cũ.
+// Khi config "app.swap.warn_stale" bật, một warning được log qua Log() nếu có
+// services đã resolve có thể giữ reference tới instance cũ.
+//
+// Tham số:
+//   - abstract: string - Tên service cần thay
+//   - impl: interface{} - Instance thay thế
+//
+// Trả về:
+//   - *BindingSwap: Swap đã thực hiện
+//   - error: Lỗi nếu service chưa được bind qua application
+func (a *application) Swap(abstract string, impl interface{}) (*BindingSwap, error) {
+	if !a.container.Bound(abstract) {
+		return nil, fmt.Errorf("cannot swap service '%s': not bound", abstract)
+	}
+	original, ok := a.container.definition(abstract)
+	if !ok {
+		return nil, fmt.Errorf("cannot swap service '%s': binding definition is unknown", abstract)
+	}
+
+	swap := &BindingSwap{
+		Abstract: abstract,
+		Stale:    a.container.dependentsOf(abstract),
+		restore: func() {
+			original.apply(a.container)
+		},
+	}
+	a.container.Instance(abstract, impl)
+
+	if len(swap.Stale) > 0 && a.warnStale() {
+		if logger, ok := a.logManager(); ok {
+			logger.Warning("service '%s' was swapped after %s resolved; they may hold stale references",
+				abstract, quoteServices(swap.Stale))
+		}
+	}
+	return swap, nil
+}
+
+// warnStale đọc config "app.swap.warn_stale".
+//
+// Trả về:
+//   - bool: true nếu Swap() cần log warning về stale references
+func (a *application) warnStale() bool {
+	cfg, ok := a.configManager()
+	if !ok {
+		return false
+	}
+	enabled, ok := cfg.GetBool("app.swap.warn_stale")
+	return ok && enabled
+}
@@ -0,0 +1,153 @@
+package core_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.fork.vn/core"
+	"go.fork.vn/di"
+)
+
+// factoryProvider là lifecycleProvider bind factory resolve các services nó
+// require qua container được truyền vào factory.
+type factoryProvider struct {
+	lifecycleProvider
+	shared bool
+	calls  int
+}
+
+func (p *factoryProvider) Register(app di.Application) {
+	for _, service := range p.provides {
+		name := service
+		factory := func(c di.Container) interface{} {
+			for _, dependency := range p.requires {
+				c.MustMake(dependency)
+			}
+			p.calls++
+			return fmt.Sprintf("%s#%d", name, p.calls)
+		}
+		if p.shared {
+			app.Singleton(name, factory)
+		} else {
+			app.Bind(name, factory)
+		}
+	}
+}
+
+// newFactoryProvider tạo factoryProvider bind singleton.
+func newFactoryProvider(provides string, requires ...string) *factoryProvider {
+	return &factoryProvider{lifecycleProvider: *newLifecycleProvider(provides, requires...), shared: true}
+}
+
+// TestApplication_Swap tests replacing bindings with fakes
+func TestApplication_Swap(t *testing.T) {
+	// bootedApp tạo application đã boot với database <- repository <- handler;
+	// reporting require database nhưng không resolve nó.
+	bootedApp := func(t *testing.T) core.Application {
+		app := core.New(map[string]interface{}{})
+		app.Register(newBindingProvider("database", "primary"))
+		app.Register(newFactoryProvider("repository", "database"))
+		app.Register(newFactoryProvider("handler", "repository"))
+		app.Register(newBindingProvider("reporting", "reporting", "database"))
+		app.Register(newBindingProvider("mailer", "smtp"))
+		require.NoError(t, app.Boot())
+		return app
+	}
+
+	t.Run("replaces_and_restores_binding", func(t *testing.T) {
+		t.Parallel()
+
+		app := bootedApp(t)
+		swap, err := app.Swap("database", "fake")
+		require.NoError(t, err)
+		assert.Equal(t, "fake", app.MustMake("database"))
+
+		swap.Restore()
+		swap.Restore()
+		assert.Equal(t, "primary", app.MustMake("database"))
+	})
+
+	t.Run("reports_resolved_dependents_as_stale", func(t *testing.T) {
+		t.Parallel()
+
+		app := bootedApp(t)
+		app.MustMake("handler")
+		app.MustMake("reporting")
+		app.MustMake("mailer")
+
+		swap, err := app.Swap("database", "fake")
+		require.NoError(t, err)
+		defer swap.Restore()
+
+		assert.Equal(t, []string{"repository", "handler"}, swap.Stale)
+	})
+
+	t.Run("does_not_resolve_swapped_service", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		provider := newFactoryProvider("cache")
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+
+		swap, err := app.Swap("cache", "fake")
+		require.NoError(t, err)
+		assert.Equal(t, 0, provider.calls)
+
+		swap.Restore()
+		assert.Equal(t, 0, provider.calls)
+		assert.Equal(t, "cache#1", app.MustMake("cache"))
+	})
+
+	t.Run("restores_transient_binding", func(t *testing.T) {
+		t.Parallel()
+
+		app := core.New(map[string]interface{}{})
+		provider := newFactoryProvider("request")
+		provider.shared = false
+		app.Register(provider)
+		require.NoError(t, app.Boot())
+		assert.Equal(t, "request#1", app.MustMake("request"))
+
+		swap, err := app.Swap("request", "fake")
+		require.NoError(t, err)
+		swap.Restore()
+
+		assert.Equal(t, "request#2", app.MustMake("request"))
+		assert.Equal(t, "request#3", app.MustMake("request"))
+	})
+
+	t.Run("restores_resolved_singleton", func(t *testing.T) {
+		t.Parallel()
+
+		app := bootedApp(t)
+		assert.Equal(t, "repository#1", app.MustMake("repository"))
+
+		swap, err := app.Swap("repository", "fake")
+		require.NoError(t, err)
+		swap.Restore()
+
+		assert.Equal(t, "repository#1", app.MustMake("repository"))
+	})
+
+	t.Run("no_stale_services_before_resolution", func(t *testing.T) {
+		t.Parallel()
+
+		app := bootedApp(t)
+		swap, err := app.Swap("database", "fake")
+		require.NoError(t, err)
+		defer swap.Restore()
+
+		assert.Empty(t, swap.Stale)
+	})
+
+	t.Run("rejects_unbound_service", func(t *testing.T) {
+		t.Parallel()
+
+		app := bootedApp(t)
+		_, err := app.Swap("cache", "fake")
+		assert.EqualError(t, err, "cannot swap service 'cache': not bound")
+	})
+}
@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+)
+
+// Span là một thao tác được đo bởi Tracer.
+//
+// Implementation phải an toàn khi gọi đồng thời.
+type Span interface {
+	// SetAttribute gắn thêm attribute cho span.
+	//
+	// Tham số:
+	//   - key: string - Tên attribute
+	//   - value: interface{} - Giá trị attribute
+	SetAttribute(key string, value interface{})
+
+	// End kết thúc span. Chỉ lần gọi đầu tiên có hiệu lực.
+	//
+	// Tham số:
+	//   - err: error - Lỗi của thao tác, nil nếu thành công
+	End(err error)
+}
+
+// Tracer là hook tạo spans cho bootstrap, config, Register/Boot của từng provider và Make.
+//
+// Cấu hình qua Application.SetTracer(); JSONTracer là implementation có sẵn
+// ghi spans ra file hoặc stdout. Span mới là con của span nằm trong ctx.
+type Tracer interface {
+	// Start bắt đầu span mới.
+	//
+	// Tham số:
+	//   - ctx: context.Context - Context chứa span cha (nếu có)
+	//   - name: string - Tên span, ví dụ "provider.boot"
+	//
+	// Trả về:
+	//   - context.Context: Context chứa span mới, dùng làm cha cho các spans con
+	//   - Span: Span mới
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Tên các spans mà application tạo qua Tracer.
+const (
+	// SpanBootstrap bao toàn bộ BootstrapApplication()
+	SpanBootstrap = "bootstrap"
+	// SpanConfig bao việc nạp config trong applyConfig, attribute "source"
+	SpanConfig = "config.apply"
+	// SpanProviderRegister bao Register của một provider, attribute "provider"
+	SpanProviderRegister = "provider.register"
+	// SpanProviderBoot bao Boot/BootContext của một provider, attribute "provider"
+	SpanProviderBoot = "provider.boot"
+	// SpanMake bao một lần resolve qua Make/MustMake, attribute "abstract"
+	SpanMake = "container.make"
+)
+
+// noopSpan là Span không làm gì, dùng khi chưa cấu hình tracer.
+type noopSpan struct{}
+
+// SetAttribute implement Span interface method.
+func (noopSpan) SetAttribute(key string, value interface{}) {}
+
+// End implement Span interface method.
+func (noopSpan) End(err error) {}
+
+// Tracer trả về tracer đã cấu hình qua SetTracer().
+//
+// Implement Application interface method.
+//
+// Trả về:
+//   - Tracer: Tracer hiện tại, nil nếu chưa cấu hình
+func (a *application) Tracer() Tracer {
+	a.tracerMu.RLock()
+	defer a.tracerMu.RUnlock()
+	return a.tracer
+}
+
+// SetTracer cấu hình tracer cho application.
+//
+// Implement Application interface method.
+//
+// Tham số:
+//   - tracer: Tracer - Tracer implementation, nil để tắt
+func (a *application) SetTracer(tracer Tracer) {
+	a.tracerMu.Lock()
+	defer a.tracerMu.Unlock()
+	a.tracer = tracer
+}
+
+// startSpan bắt đầu span qua tracer của application.
+//
+// Tham số:
+//   - ctx: context.Context - Context chứa span cha
+//   - name: string - Tên span
+//   - attributes: ...interface{} - Các cặp key, value gắn vào span
+//
+// Trả về:
+//   - context.Context: Context chứa span mới, ctx nếu chưa cấu hình tracer
+//   - Span: Span mới, noopSpan nếu chưa cấu hình tracer
+func (a *application) startSpan(ctx context.Context, name string, attributes ...interface{}) (context.Context, Span) {
+	return startSpan(a.Tracer(), ctx, name, attributes...)
+}
+
+// startSpan bắt đầu span nếu tracer khác nil.
+//
+// Tham số:
+//   - tracer: Tracer - Tracer tạo span, nil nếu chưa cấu hình
+//   - ctx: context.Context - Context chứa span cha
+//   - name: string - Tên span
+//   - attributes: ...interface{} - Các cặp key, value gắn vào span
+//
+// Trả về:
+//   - context.Context: Context chứa span mới, ctx nếu tracer là nil
+//   - Span: Span mới, noopSpan nếu tracer là nil
+func startSpan(tracer Tracer, ctx context.Context, name string, attributes ...interface{}) (context.Context, Span) {
+	if tracer == nil {
+		return ctx, noopSpan{}
+	}
+
+	ctx, span := tracer.Start(ctx, name)
+	for i := 0; i+1 < len(attributes); i += 2 {
+		if key, ok := attributes[i].(string); ok {
+			span.SetAttribute(key, attributes[i+1])
+		}
+	}
+	return ctx, span
+}
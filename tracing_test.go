@@ -0,0 +1,230 @@
+package core_test
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	configMocks "go.fork.vn/config/mocks"
+	"go.fork.vn/core"
+	"go.fork.vn/core/coretest"
+	"go.fork.vn/di"
+)
+
+// decodeSpans đọc các span records từ output JSON Lines của JSONTracer.
+func decodeSpans(t *testing.T, data []byte) []core.SpanRecord {
+	t.Helper()
+
+	spans := make([]core.SpanRecord, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var span core.SpanRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &span))
+		spans = append(spans, span)
+	}
+	require.NoError(t, scanner.Err())
+	return spans
+}
+
+// findSpan trả về span đầu tiên có tên và attribute cho trước.
+func findSpan(t *testing.T, spans []core.SpanRecord, name, key string, value interface{}) core.SpanRecord {
+	t.Helper()
+
+	for _, span := range spans {
+		if span.Name == name && (key == "" || span.Attributes[key] == value) {
+			return span
+		}
+	}
+	t.Fatalf("span %s with %s=%v not found", name, key, value)
+	return core.SpanRecord{}
+}
+
+// TestApplication_Tracing tests spans for bootstrap and container resolution
+func TestApplication_Tracing(t *testing.T) {
+	setupTestEnvironment(t)
+
+	t.Run("nests_bootstrap_steps_under_bootstrap_span", func(t *testing.T) {
+		t.Parallel()
+
+		var out bytes.Buffer
+		tracer := core.NewJSONTracer(&out)
+		app := core.New(map[string]interface{}{
+			"file": "testdata/configs/console-only-simple.yaml",
+		})
+		app.SetTracer(tracer)
+		app.Register(newBindingProvider("database", "primary"))
+
+		require.NoError(t, app.ModuleLoader().BootstrapApplication())
+		require.NoError(t, tracer.Err())
+
+		spans := decodeSpans(t, out.Bytes())
+		root := findSpan(t, spans, core.SpanBootstrap, "", nil)
+		assert.Empty(t, root.ParentID)
+		assert.Empty(t, root.Error)
+		assert.Equal(t, core.SpanBootstrap, spans[len(spans)-1].Name)
+
+		config := findSpan(t, spans, core.SpanConfig, "source", "file")
+		register := findSpan(t, spans, core.SpanProviderRegister, "provider", "*core_test.bindingProvider")
+		boot := findSpan(t, spans, core.SpanProviderBoot, "provider", "*core_test.bindingProvider")
+		for _, span := range []core.SpanRecord{config, register, boot} {
+			assert.Equal(t, root.TraceID, span.TraceID)
+			assert.Equal(t, root.SpanID, span.ParentID)
+			assert.LessOrEqual(t, span.Duration, root.Duration)
+		}
+	})
+
+	t.Run("records_provider_boot_errors", func(t *testing.T) {
+		t.Parallel()
+
+		var out bytes.Buffer
+		app := core.New(map[string]interface{}{})
+		app.SetTracer(core.NewJSONTracer(&out))
+		app.Register(&contextProvider{
+			provides: []string{"database"},
+			boot: func(ctx context.Context, app di.Application) error {
+				return assert.AnError
+			},
+		})
+		require.Error(t, app.Boot())
+
+		boot := findSpan(t, decodeSpans(t, out.Bytes()), core.SpanProviderBoot, "provider", "*core_test.contextProvider")
+		assert.Contains(t, boot.Error, assert.AnError.Error())
+	})
+
+	t.Run("traces_make_as_root_span_outside_bootstrap", func(t *testing.T) {
+		t.Parallel()
+
+		var out bytes.Buffer
+		app := core.New(map[string]interface{}{})
+		app.SetTracer(core.NewJSONTracer(&out))
+		app.Instance("cache", "redis")
+
+		app.MustMake("cache")
+		_, err := app.Make("missing")
+		require.Error(t, err)
+
+		spans := decodeSpans(t, out.Bytes())
+		cache := findSpan(t, spans, core.SpanMake, "abstract", "cache")
+		assert.Empty(t, cache.ParentID)
+		assert.Empty(t, cache.Error)
+		assert.NotEmpty(t, findSpan(t, spans, core.SpanMake, "abstract", "missing").Error)
+	})
+
+	t.Run("nests_make_context_under_provider_boot", func(t *testing.T) {
+		t.Parallel()
+
+		var out bytes.Buffer
+		app := core.New(map[string]interface{}{})
+		app.SetTracer(core.NewJSONTracer(&out))
+		app.Register(newBindingProvider("database", "primary"))
+		app.Register(&contextProvider{
+			provides: []string{"repository"},
+			requires: []string{"database"},
+			boot: func(ctx context.Context, app di.Application) error {
+				_, err := app.(core.Application).MakeContext(ctx, "database")
+				return err
+			},
+		})
+		require.NoError(t, app.RegisterWithDependencies())
+		require.NoError(t, app.Boot())
+
+		spans := decodeSpans(t, out.Bytes())
+		boot := findSpan(t, spans, core.SpanProviderBoot, "provider", "*core_test.contextProvider")
+		resolve := findSpan(t, spans, core.SpanMake, "abstract", "database")
+		assert.Equal(t, boot.TraceID, resolve.TraceID)
+		assert.Equal(t, boot.SpanID, resolve.ParentID)
+	})
+
+	t.Run("does_not_parent_concurrent_make_to_bootstrap", func(t *testing.T) {
+		t.Parallel()
+
+		var out bytes.Buffer
+		app := core.New(map[string]interface{}{
+			"file": "testdata/configs/console-only-simple.yaml",
+		})
+		app.SetTracer(core.NewJSONTracer(&out))
+		app.Instance("cache", "redis")
+
+		booting := make(chan struct{})
+		resolved := make(chan struct{})
+		app.Register(&contextProvider{
+			provides: []string{"worker"},
+			boot: func(ctx context.Context, app di.Application) error {
+				close(booting)
+				<-resolved
+				return nil
+			},
+		})
+		go func() {
+			<-booting
+			app.MustMake("cache")
+			close(resolved)
+		}()
+		require.NoError(t, app.ModuleLoader().BootstrapApplication())
+
+		cache := findSpan(t, decodeSpans(t, out.Bytes()), core.SpanMake, "abstract", "cache")
+		assert.Empty(t, cache.ParentID)
+	})
+
+	t.Run("nests_register_spans_under_boot_context", func(t *testing.T) {
+		t.Parallel()
+
+		var out bytes.Buffer
+		tracer := core.NewJSONTracer(&out)
+		app := core.New(map[string]interface{}{})
+		app.SetTracer(tracer)
+		app.Register(newBindingProvider("database", "primary"))
+
+		ctx, span := tracer.Start(context.Background(), "request")
+		require.NoError(t, app.BootContext(ctx))
+		span.End(nil)
+
+		spans := decodeSpans(t, out.Bytes())
+		root := findSpan(t, spans, "request", "", nil)
+		register := findSpan(t, spans, core.SpanProviderRegister, "provider", "*core_test.bindingProvider")
+		assert.Equal(t, root.SpanID, register.ParentID)
+	})
+
+	t.Run("does_not_trace_config_and_log_accessors", func(t *testing.T) {
+		t.Parallel()
+
+		var out bytes.Buffer
+		app := core.New(map[string]interface{}{})
+		app.Instance("config", configMocks.NewMockManager(t))
+		app.Instance("log", &coretest.LogRecorder{})
+		app.SetTracer(core.NewJSONTracer(&out))
+
+		app.Config()
+		app.Log()
+
+		assert.Empty(t, out.String())
+	})
+
+	t.Run("writes_spans_to_file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "trace.jsonl")
+		tracer, err := core.NewFileTracer(path)
+		require.NoError(t, err)
+
+		app := core.New(map[string]interface{}{})
+		app.SetTracer(tracer)
+		app.Instance("cache", "redis")
+		app.MustMake("cache")
+		require.NoError(t, tracer.Close())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		spans := decodeSpans(t, data)
+		require.Len(t, spans, 1)
+		assert.Equal(t, core.SpanMake, spans[0].Name)
+		assert.Len(t, spans[0].TraceID, 32)
+		assert.Len(t, spans[0].SpanID, 16)
+	})
+}
@@ -0,0 +1,6 @@
+package core
+
+// Version là version của go.fork.vn/core.
+//
+// Được dùng để kiểm tra ModuleManifest.MinCoreVersion của các modules khi load.
+const Version = "0.1.1"